@@ -0,0 +1,131 @@
+package azurerm
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// The App Roles and OAuth2 Permissions of an Application aren't available in the Graph SDK in use, and whilst the
+// Required Resource Access can be set it can't be retrieved - as such this is a minimal client for these properties
+// of an Application.
+const azureADApplicationsAPIVersion = "1.6"
+
+type azureADApplicationsClient struct {
+	autorest.Client
+	BaseURI  string
+	TenantID string
+}
+
+type azureADApplicationProperties struct {
+	autorest.Response `json:"-"`
+
+	AppRoles               *[]azureADApplicationAppRole          `json:"appRoles,omitempty"`
+	OAuth2Permissions      *[]azureADApplicationOAuth2Permission `json:"oauth2Permissions,omitempty"`
+	RequiredResourceAccess *[]graphrbac.RequiredResourceAccess   `json:"requiredResourceAccess,omitempty"`
+}
+
+type azureADApplicationAppRole struct {
+	ID                 *string   `json:"id,omitempty"`
+	AllowedMemberTypes *[]string `json:"allowedMemberTypes,omitempty"`
+	Description        *string   `json:"description,omitempty"`
+	DisplayName        *string   `json:"displayName,omitempty"`
+	IsEnabled          *bool     `json:"isEnabled,omitempty"`
+	Value              *string   `json:"value,omitempty"`
+}
+
+type azureADApplicationOAuth2Permission struct {
+	ID                      *string `json:"id,omitempty"`
+	AdminConsentDescription *string `json:"adminConsentDescription,omitempty"`
+	AdminConsentDisplayName *string `json:"adminConsentDisplayName,omitempty"`
+	IsEnabled               *bool   `json:"isEnabled,omitempty"`
+	Type                    *string `json:"type,omitempty"`
+	UserConsentDescription  *string `json:"userConsentDescription,omitempty"`
+	UserConsentDisplayName  *string `json:"userConsentDisplayName,omitempty"`
+	Value                   *string `json:"value,omitempty"`
+}
+
+func newAzureADApplicationsClientWithBaseURI(baseURI string, tenantID string) azureADApplicationsClient {
+	return azureADApplicationsClient{
+		Client:   autorest.NewClientWithUserAgent(""),
+		BaseURI:  baseURI,
+		TenantID: tenantID,
+	}
+}
+
+func (client azureADApplicationsClient) Get(ctx context.Context, objectID string) (result azureADApplicationProperties, err error) {
+	req, err := client.preparer(ctx, objectID, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.azureADApplicationsClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azurerm.azureADApplicationsClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.azureADApplicationsClient", "Get", resp, "Failure responding to request")
+	}
+	return
+}
+
+// Patch updates the specified properties of the Application - properties which are nil are left unchanged
+func (client azureADApplicationsClient) Patch(ctx context.Context, objectID string, parameters azureADApplicationProperties) (result autorest.Response, err error) {
+	req, err := client.preparer(ctx, objectID,
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPatch(),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.azureADApplicationsClient", "Patch", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		result.Response = resp
+		err = autorest.NewErrorWithError(err, "azurerm.azureADApplicationsClient", "Patch", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	result.Response = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.azureADApplicationsClient", "Patch", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (client azureADApplicationsClient) preparer(ctx context.Context, objectID string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"applicationObjectId": autorest.Encode("path", objectID),
+		"tenantID":            autorest.Encode("path", client.TenantID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": azureADApplicationsAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/{tenantID}/applications/{applicationObjectId}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
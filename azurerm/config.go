@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net/http"
-	"net/http/httputil"
+	"math"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,6 +17,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/containerinstance/mgmt/2018-04-01/containerinstance"
 	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2017-10-01/containerregistry"
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+	agentPools "github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-02-01/containerservice"
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
 	analyticsAccount "github.com/Azure/azure-sdk-for-go/services/datalake/analytics/mgmt/2016-11-01/account"
 	"github.com/Azure/azure-sdk-for-go/services/datalake/store/2016-11-01/filesystem"
@@ -41,6 +42,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/operationsmanagement/mgmt/2015-11-01-preview/operationsmanagement"
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/management"
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2015-05-01-preview/sql"
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
 	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2016-06-01/recoveryservices"
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2018-03-01/redis"
 	"github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
@@ -74,6 +76,18 @@ type ArmClient struct {
 	usingServicePrincipal    bool
 	environment              azure.Environment
 	skipProviderRegistration bool
+	maxRetries               int
+	maxRetryWait             time.Duration
+	defaultTags              map[string]string
+	ignoreTags               ignoreTagsConfig
+
+	// the credentials used by each client, which are reused when building clients for other Subscriptions
+	resourceManagerAuth autorest.Authorizer
+	graphAuth           autorest.Authorizer
+	keyVaultAuth        autorest.Authorizer
+
+	subscriptionClients     map[string]*ArmClient
+	subscriptionClientsLock sync.Mutex
 
 	StopContext context.Context
 
@@ -88,10 +102,15 @@ type ArmClient struct {
 	dnsClient   dns.RecordSetsClient
 	zonesClient dns.ZonesClient
 
-	containerRegistryClient  containerregistry.RegistriesClient
-	containerServicesClient  containerservice.ContainerServicesClient
-	kubernetesClustersClient containerservice.ManagedClustersClient
-	containerGroupsClient    containerinstance.ContainerGroupsClient
+	privateDnsRecordSetsClient          privatedns.RecordSetsClient
+	privateDnsVirtualNetworkLinksClient privatedns.VirtualNetworkLinksClient
+	privateDnsZonesClient               privatedns.PrivateZonesClient
+
+	containerRegistryClient    containerregistry.RegistriesClient
+	containerServicesClient    containerservice.ContainerServicesClient
+	kubernetesClustersClient   containerservice.ManagedClustersClient
+	kubernetesAgentPoolsClient agentPools.AgentPoolsClient
+	containerGroupsClient      containerinstance.ContainerGroupsClient
 
 	eventGridTopicsClient       eventgrid.TopicsClient
 	eventHubClient              eventhub.EventHubsClient
@@ -109,10 +128,13 @@ type ArmClient struct {
 	appInsightsClient appinsights.ComponentsClient
 
 	// Authentication
-	roleAssignmentsClient   authorization.RoleAssignmentsClient
-	roleDefinitionsClient   authorization.RoleDefinitionsClient
-	applicationsClient      graphrbac.ApplicationsClient
-	servicePrincipalsClient graphrbac.ServicePrincipalsClient
+	roleAssignmentsClient       authorization.RoleAssignmentsClient
+	roleDefinitionsClient       authorization.RoleDefinitionsClient
+	applicationsClient          graphrbac.ApplicationsClient
+	applicationPropertiesClient azureADApplicationsClient
+	groupsClient                graphrbac.GroupsClient
+	servicePrincipalsClient     graphrbac.ServicePrincipalsClient
+	usersClient                 graphrbac.UsersClient
 
 	// Autoscale Settings
 	autoscaleSettingsClient insights.AutoscaleSettingsClient
@@ -131,6 +153,7 @@ type ArmClient struct {
 	vmExtensionImageClient compute.VirtualMachineExtensionImagesClient
 	vmExtensionClient      compute.VirtualMachineExtensionsClient
 	vmScaleSetClient       compute.VirtualMachineScaleSetsClient
+	rollingUpgradesClient  compute.VirtualMachineScaleSetRollingUpgradesClient
 	vmImageClient          compute.VirtualMachineImagesClient
 	vmClient               compute.VirtualMachinesClient
 
@@ -191,6 +214,7 @@ type ArmClient struct {
 	applicationGatewayClient        network.ApplicationGatewaysClient
 	applicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
 	azureFirewallsClient            network.AzureFirewallsClient
+	firewallRuleCollectionsClient   azureFirewallRuleCollectionsClient
 	expressRouteAuthsClient         network.ExpressRouteCircuitAuthorizationsClient
 	expressRouteCircuitClient       network.ExpressRouteCircuitsClient
 	expressRoutePeeringsClient      network.ExpressRouteCircuitPeeringsClient
@@ -222,12 +246,14 @@ type ArmClient struct {
 	relayNamespacesClient relay.NamespacesClient
 
 	// Resources
-	managementLocksClient locks.ManagementLocksClient
-	deploymentsClient     resources.DeploymentsClient
-	providersClient       resources.ProvidersClient
-	resourcesClient       resources.Client
-	resourceGroupsClient  resources.GroupsClient
-	subscriptionsClient   subscriptions.Client
+	managementLocksClient      locks.ManagementLocksClient
+	deploymentsClient          resources.DeploymentsClient
+	deploymentOperationsClient resources.DeploymentOperationsClient
+	deploymentsAtScopeClient   templateDeploymentsAtScopeClient
+	providersClient            resources.ProvidersClient
+	resourcesClient            resources.Client
+	resourceGroupsClient       resources.GroupsClient
+	subscriptionsClient        subscriptions.Client
 
 	// Scheduler
 	schedulerJobCollectionsClient scheduler.JobCollectionsClient
@@ -264,40 +290,24 @@ type ArmClient struct {
 	policyDefinitionsClient policy.DefinitionsClient
 }
 
+// pollingDuration is the upper bound for how long a long-running operation is polled by the Azure SDK. The per-resource
+// timeouts are enforced via the context passed to each request (which the SDK polls with), so this is effectively
+// unbounded - such that the context's deadline is used, rather than capping any timeout which exceeds it
+const pollingDuration = time.Duration(math.MaxInt64)
+
 func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
 	setUserAgent(client)
 	client.Authorizer = auth
-	client.Sender = autorest.CreateSender(withRequestLogging())
+	client.Sender = c.buildSender()
 	client.SkipResourceProviderRegistration = c.skipProviderRegistration
-	client.PollingDuration = 60 * time.Minute
+	client.PollingDuration = pollingDuration
+	disableSdkRetries(client)
 }
 
-func withRequestLogging() autorest.SendDecorator {
-	return func(s autorest.Sender) autorest.Sender {
-		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
-			// dump request to wire format
-			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
-				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
-			} else {
-				// fallback to basic message
-				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, r.URL)
-			}
-
-			resp, err := s.Do(r)
-			if resp != nil {
-				// dump response to wire format
-				if dump, err := httputil.DumpResponse(resp, true); err == nil {
-					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
-				} else {
-					// fallback to basic message
-					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
-				}
-			} else {
-				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
-			}
-			return resp, err
-		})
-	}
+// buildSender returns the Sender used for all requests to Azure - which retries throttled/transient failures
+// and logs each individual attempt
+func (c *ArmClient) buildSender() autorest.Sender {
+	return autorest.CreateSender(withRequestLogging(), withRequestRetries(c.maxRetries, c.maxRetryWait))
 }
 
 func setUserAgent(client *autorest.Client) {
@@ -317,6 +327,21 @@ func setUserAgent(client *autorest.Client) {
 }
 
 func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConfig, endpoint string) (*autorest.BearerAuthorizer, error) {
+	if c.ClientCertPath != "" {
+		certificate, privateKey, err := c.LoadClientCertificate()
+		if err != nil {
+			return nil, err
+		}
+
+		spt, err := adal.NewServicePrincipalTokenFromCertificate(*oauthConfig, c.ClientID, certificate, privateKey, endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		auth := autorest.NewBearerAuthorizer(spt)
+		return auth, nil
+	}
+
 	useServicePrincipal := c.ClientSecret != ""
 
 	if useServicePrincipal {
@@ -375,14 +400,20 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		}
 	}
 
+	if c.CustomResourceManagerEndpoint != "" {
+		env.ResourceManagerEndpoint = c.CustomResourceManagerEndpoint
+	}
+
 	// client declarations:
 	client := ArmClient{
 		clientId:                 c.ClientID,
 		tenantId:                 c.TenantID,
 		subscriptionId:           c.SubscriptionID,
 		environment:              env,
-		usingServicePrincipal:    c.ClientSecret != "",
+		usingServicePrincipal:    c.ClientSecret != "" || c.ClientCertPath != "",
 		skipProviderRegistration: c.SkipProviderRegistration,
+		maxRetries:               c.MaxRetries,
+		maxRetryWait:             c.MaxRetryWait,
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -395,71 +426,145 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
 	}
 
-	sender := autorest.CreateSender(withRequestLogging())
+	sender := client.buildSender()
 
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
-	auth, err := getAuthorizationToken(c, oauthConfig, endpoint)
-	if err != nil {
-		return nil, err
-	}
-
-	// Graph Endpoints
 	graphEndpoint := env.GraphEndpoint
-	graphAuth, err := getAuthorizationToken(c, oauthConfig, graphEndpoint)
-	if err != nil {
-		return nil, err
-	}
 
-	// Key Vault Endpoints
-	keyVaultAuth := autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
-		keyVaultSpt, err := getAuthorizationToken(c, oauthConfig, resource)
+	var auth, graphAuth, keyVaultAuth autorest.Authorizer
+	if c.CustomAuthorizer != nil {
+		// a custom authorizer is used when running against a local stand-in for Azure (e.g. in unit tests)
+		auth = c.CustomAuthorizer
+		graphAuth = c.CustomAuthorizer
+		keyVaultAuth = c.CustomAuthorizer
+	} else {
+		auth, err = getAuthorizationToken(c, oauthConfig, endpoint)
 		if err != nil {
 			return nil, err
 		}
 
-		return keyVaultSpt, nil
-	})
-
-	client.registerAppInsightsClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerAutomationClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth, sender)
-	client.registerCDNClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerComputeClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerContainerInstanceClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerContainerRegistryClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerContainerServicesClients(endpoint, c.SubscriptionID, auth)
-	client.registerCosmosDBClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDatabases(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDataLakeStoreClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDeviceClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDevTestClients(endpoint, c.SubscriptionID, auth)
-	client.registerDNSClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerEventGridClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerEventHubClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerKeyVaultClients(endpoint, c.SubscriptionID, auth, keyVaultAuth, sender)
-	client.registerLogicClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerMonitorClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerNetworkingClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerNotificationHubsClient(endpoint, c.SubscriptionID, auth, sender)
-	client.registerOperationalInsightsClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerRecoveryServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerPolicyClients(endpoint, c.SubscriptionID, auth)
-	client.registerManagementGroupClients(endpoint, auth)
-	client.registerRedisClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerRelayClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerResourcesClients(endpoint, c.SubscriptionID, auth)
-	client.registerSearchClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceBusClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceFabricClients(endpoint, c.SubscriptionID, auth)
-	client.registerSchedulerClients(endpoint, c.SubscriptionID, auth)
-	client.registerStorageClients(endpoint, c.SubscriptionID, auth)
-	client.registerTrafficManagerClients(endpoint, c.SubscriptionID, auth)
-	client.registerWebClients(endpoint, c.SubscriptionID, auth)
+		// Graph Endpoints
+		graphAuth, err = getAuthorizationToken(c, oauthConfig, graphEndpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		// Key Vault Endpoints
+		keyVaultAuth = autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+			keyVaultSpt, err := getAuthorizationToken(c, oauthConfig, resource)
+			if err != nil {
+				return nil, err
+			}
+
+			return keyVaultSpt, nil
+		})
+	}
+
+	client.resourceManagerAuth = auth
+	client.graphAuth = graphAuth
+	client.keyVaultAuth = keyVaultAuth
+	client.registerClients(c.SubscriptionID, sender)
 
 	return &client, nil
 }
 
+// registerClients configures each of the clients for the specified Subscription
+func (c *ArmClient) registerClients(subscriptionId string, sender autorest.Sender) {
+	endpoint := c.environment.ResourceManagerEndpoint
+	graphEndpoint := c.environment.GraphEndpoint
+	auth := c.resourceManagerAuth
+	graphAuth := c.graphAuth
+	keyVaultAuth := c.keyVaultAuth
+
+	c.registerAppInsightsClients(endpoint, subscriptionId, auth, sender)
+	c.registerAutomationClients(endpoint, subscriptionId, auth, sender)
+	c.registerAuthentication(endpoint, graphEndpoint, subscriptionId, c.tenantId, auth, graphAuth, sender)
+	c.registerCDNClients(endpoint, subscriptionId, auth, sender)
+	c.registerComputeClients(endpoint, subscriptionId, auth, sender)
+	c.registerContainerInstanceClients(endpoint, subscriptionId, auth, sender)
+	c.registerContainerRegistryClients(endpoint, subscriptionId, auth, sender)
+	c.registerContainerServicesClients(endpoint, subscriptionId, auth)
+	c.registerCosmosDBClients(endpoint, subscriptionId, auth, sender)
+	c.registerDatabases(endpoint, subscriptionId, auth, sender)
+	c.registerDataLakeStoreClients(endpoint, subscriptionId, auth, sender)
+	c.registerDeviceClients(endpoint, subscriptionId, auth, sender)
+	c.registerDevTestClients(endpoint, subscriptionId, auth)
+	c.registerDNSClients(endpoint, subscriptionId, auth, sender)
+	c.registerEventGridClients(endpoint, subscriptionId, auth, sender)
+	c.registerEventHubClients(endpoint, subscriptionId, auth, sender)
+	c.registerKeyVaultClients(endpoint, subscriptionId, auth, keyVaultAuth, sender)
+	c.registerLogicClients(endpoint, subscriptionId, auth, sender)
+	c.registerMonitorClients(endpoint, subscriptionId, auth, sender)
+	c.registerNetworkingClients(endpoint, subscriptionId, auth, sender)
+	c.registerNotificationHubsClient(endpoint, subscriptionId, auth, sender)
+	c.registerOperationalInsightsClients(endpoint, subscriptionId, auth, sender)
+	c.registerRecoveryServiceClients(endpoint, subscriptionId, auth)
+	c.registerPolicyClients(endpoint, subscriptionId, auth)
+	c.registerManagementGroupClients(endpoint, auth)
+	c.registerRedisClients(endpoint, subscriptionId, auth, sender)
+	c.registerRelayClients(endpoint, subscriptionId, auth, sender)
+	c.registerResourcesClients(endpoint, subscriptionId, auth)
+	c.registerSearchClients(endpoint, subscriptionId, auth)
+	c.registerServiceBusClients(endpoint, subscriptionId, auth)
+	c.registerServiceFabricClients(endpoint, subscriptionId, auth)
+	c.registerSchedulerClients(endpoint, subscriptionId, auth)
+	c.registerStorageClients(endpoint, subscriptionId, auth)
+	c.registerTrafficManagerClients(endpoint, subscriptionId, auth)
+	c.registerWebClients(endpoint, subscriptionId, auth)
+
+}
+
+// forSubscription returns a client for the specified Subscription, reusing the credentials (and configuration)
+// of this client. Clients for other Subscriptions are built the first time they're needed and then cached.
+func (c *ArmClient) forSubscription(subscriptionId string) *ArmClient {
+	if subscriptionId == "" || strings.EqualFold(subscriptionId, c.subscriptionId) {
+		return c
+	}
+
+	c.subscriptionClientsLock.Lock()
+	defer c.subscriptionClientsLock.Unlock()
+
+	key := strings.ToLower(subscriptionId)
+	if client, ok := c.subscriptionClients[key]; ok {
+		return client
+	}
+
+	log.Printf("[DEBUG] Building a client for Subscription %q", subscriptionId)
+	client := &ArmClient{
+		clientId:                 c.clientId,
+		tenantId:                 c.tenantId,
+		subscriptionId:           subscriptionId,
+		usingServicePrincipal:    c.usingServicePrincipal,
+		environment:              c.environment,
+		skipProviderRegistration: c.skipProviderRegistration,
+		maxRetries:               c.maxRetries,
+		maxRetryWait:             c.maxRetryWait,
+		defaultTags:              c.defaultTags,
+		ignoreTags:               c.ignoreTags,
+		resourceManagerAuth:      c.resourceManagerAuth,
+		graphAuth:                c.graphAuth,
+		keyVaultAuth:             c.keyVaultAuth,
+		StopContext:              c.StopContext,
+	}
+	client.registerClients(subscriptionId, client.buildSender())
+
+	if c.subscriptionClients == nil {
+		c.subscriptionClients = make(map[string]*ArmClient)
+	}
+	c.subscriptionClients[key] = client
+	return client
+}
+
+// resetSubscriptionClients removes any cached clients for other Subscriptions, such that they're rebuilt
+// using the current configuration (e.g. StopContext) the next time they're needed
+func (c *ArmClient) resetSubscriptionClients() {
+	c.subscriptionClientsLock.Lock()
+	defer c.subscriptionClientsLock.Unlock()
+
+	c.subscriptionClients = nil
+}
+
 func (c *ArmClient) registerAppInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	ai := appinsights.NewComponentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ai.Client, auth)
@@ -501,9 +606,21 @@ func (c *ArmClient) registerAuthentication(endpoint, graphEndpoint, subscription
 	c.configureClient(&applicationsClient.Client, graphAuth)
 	c.applicationsClient = applicationsClient
 
+	applicationPropertiesClient := newAzureADApplicationsClientWithBaseURI(graphEndpoint, tenantId)
+	c.configureClient(&applicationPropertiesClient.Client, graphAuth)
+	c.applicationPropertiesClient = applicationPropertiesClient
+
+	groupsClient := graphrbac.NewGroupsClientWithBaseURI(graphEndpoint, tenantId)
+	c.configureClient(&groupsClient.Client, graphAuth)
+	c.groupsClient = groupsClient
+
 	servicePrincipalsClient := graphrbac.NewServicePrincipalsClientWithBaseURI(graphEndpoint, tenantId)
 	c.configureClient(&servicePrincipalsClient.Client, graphAuth)
 	c.servicePrincipalsClient = servicePrincipalsClient
+
+	usersClient := graphrbac.NewUsersClientWithBaseURI(graphEndpoint, tenantId)
+	c.configureClient(&usersClient.Client, graphAuth)
+	c.usersClient = usersClient
 }
 
 func (c *ArmClient) registerCDNClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
@@ -563,6 +680,10 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&scaleSetsClient.Client, auth)
 	c.vmScaleSetClient = scaleSetsClient
 
+	rollingUpgradesClient := compute.NewVirtualMachineScaleSetRollingUpgradesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&rollingUpgradesClient.Client, auth)
+	c.rollingUpgradesClient = rollingUpgradesClient
+
 	virtualMachinesClient := compute.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachinesClient.Client, auth)
 	c.vmClient = virtualMachinesClient
@@ -590,6 +711,10 @@ func (c *ArmClient) registerContainerServicesClients(endpoint, subscriptionId st
 	kubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&kubernetesClustersClient.Client, auth)
 	c.kubernetesClustersClient = kubernetesClustersClient
+
+	kubernetesAgentPoolsClient := agentPools.NewAgentPoolsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&kubernetesAgentPoolsClient.Client, auth)
+	c.kubernetesAgentPoolsClient = kubernetesAgentPoolsClient
 }
 
 func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
@@ -645,6 +770,7 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	sqlDTDPClient.Authorizer = auth
 	sqlDTDPClient.Sender = sender
 	sqlDTDPClient.SkipResourceProviderRegistration = c.skipProviderRegistration
+	disableSdkRetries(&sqlDTDPClient.Client)
 	c.sqlDatabaseThreatDetectionPoliciesClient = sqlDTDPClient
 
 	sqlFWClient := sql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
@@ -714,6 +840,18 @@ func (c *ArmClient) registerDNSClients(endpoint, subscriptionId string, auth aut
 	zo := dns.NewZonesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&zo.Client, auth)
 	c.zonesClient = zo
+
+	privateRecordSets := privatedns.NewRecordSetsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&privateRecordSets.Client, auth)
+	c.privateDnsRecordSetsClient = privateRecordSets
+
+	privateVirtualNetworkLinks := privatedns.NewVirtualNetworkLinksClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&privateVirtualNetworkLinks.Client, auth)
+	c.privateDnsVirtualNetworkLinksClient = privateVirtualNetworkLinks
+
+	privateZones := privatedns.NewPrivateZonesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&privateZones.Client, auth)
+	c.privateDnsZonesClient = privateZones
 }
 
 func (c *ArmClient) registerEventGridClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
@@ -779,6 +917,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&azureFirewallsClient.Client, auth)
 	c.azureFirewallsClient = azureFirewallsClient
 
+	firewallRuleCollectionsClient := newAzureFirewallRuleCollectionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&firewallRuleCollectionsClient.Client, auth)
+	c.firewallRuleCollectionsClient = firewallRuleCollectionsClient
+
 	expressRouteAuthsClient := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRouteAuthsClient.Client, auth)
 	c.expressRouteAuthsClient = expressRouteAuthsClient
@@ -856,7 +998,7 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.watcherClient = watchersClient
 }
 
-func (c *ArmClient) registerNotificationHubsClient(endpoint, subscriptionId string, auth *autorest.BearerAuthorizer, sender autorest.Sender) {
+func (c *ArmClient) registerNotificationHubsClient(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	namespacesClient := notificationhubs.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&namespacesClient.Client, auth)
 	c.notificationNamespacesClient = namespacesClient
@@ -911,6 +1053,14 @@ func (c *ArmClient) registerResourcesClients(endpoint, subscriptionId string, au
 	c.configureClient(&deploymentsClient.Client, auth)
 	c.deploymentsClient = deploymentsClient
 
+	deploymentOperationsClient := resources.NewDeploymentOperationsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&deploymentOperationsClient.Client, auth)
+	c.deploymentOperationsClient = deploymentOperationsClient
+
+	deploymentsAtScopeClient := newTemplateDeploymentsAtScopeClientWithBaseURI(endpoint)
+	c.configureClient(&deploymentsAtScopeClient.Client, auth)
+	c.deploymentsAtScopeClient = deploymentsAtScopeClient
+
 	resourcesClient := resources.NewClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&resourcesClient.Client, auth)
 	c.resourcesClient = resourcesClient
@@ -0,0 +1,104 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmActiveDirectoryGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmActiveDirectoryGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validate.UUID,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"object_id"},
+			},
+		},
+	}
+}
+
+func dataSourceArmActiveDirectoryGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).groupsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	var group *graphrbac.ADGroup
+
+	if v, ok := d.GetOk("object_id"); ok {
+		objectId := v.(string)
+		resp, err := client.Get(ctx, objectId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Azure AD Group with Object ID %q was not found!", objectId)
+			}
+
+			return fmt.Errorf("Error retrieving Azure AD Group with Object ID %q: %+v", objectId, err)
+		}
+
+		group = &resp
+	} else if v, ok := d.GetOk("name"); ok {
+		name := v.(string)
+		filter := fmt.Sprintf("displayName eq '%s'", azureADODataFilterValue(name))
+		log.Printf("[DEBUG] [data_source_azuread_group] Using filter %q", filter)
+
+		groups, err := client.ListComplete(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("Error listing Azure AD Groups: %+v", err)
+		}
+
+		matches := make([]graphrbac.ADGroup, 0)
+		for groups.NotDone() {
+			if v := groups.Value(); v.DisplayName != nil && *v.DisplayName == name {
+				matches = append(matches, v)
+			}
+
+			if err := groups.Next(); err != nil {
+				return fmt.Errorf("Error listing Azure AD Groups: %+v", err)
+			}
+		}
+
+		// Display Names aren't unique, so we can't determine which Group was intended
+		if len(matches) > 1 {
+			return fmt.Errorf("Found %d Azure AD Groups with the Name %q - use the `object_id` to specify which one", len(matches), name)
+		}
+		if len(matches) == 0 {
+			return fmt.Errorf("An Azure AD Group with the Name %q was not found", name)
+		}
+
+		group = &matches[0]
+	} else {
+		return fmt.Errorf("One of `object_id` or `name` must be specified")
+	}
+
+	if group.ObjectID == nil {
+		return fmt.Errorf("Error retrieving Azure AD Group: Object ID was nil")
+	}
+
+	d.SetId(*group.ObjectID)
+
+	d.Set("object_id", group.ObjectID)
+	d.Set("name", group.DisplayName)
+
+	return nil
+}
+
+// azureADODataFilterValue escapes the value for use within a single-quoted string in an OData filter
+func azureADODataFilterValue(input string) string {
+	return strings.Replace(input, "'", "''", -1)
+}
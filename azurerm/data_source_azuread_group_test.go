@@ -0,0 +1,105 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAzureADODataFilterValue(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Input:    "Developers",
+			Expected: "Developers",
+		},
+		{
+			Input:    "Tom's Team",
+			Expected: "Tom''s Team",
+		},
+		{
+			Input:    "'quoted'",
+			Expected: "''quoted''",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := azureADODataFilterValue(tc.Input); actual != tc.Expected {
+			t.Fatalf("Expected %q for %q but got %q", tc.Expected, tc.Input, actual)
+		}
+	}
+}
+
+func TestAccDataSourceAzureRMAzureADGroup_byName(t *testing.T) {
+	dataSourceName := "data.azurerm_azuread_group.test"
+	id := uuid.New().String()
+	config := testAccDataSourceAzureRMAzureADGroup_byName(id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMActiveDirectoryGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryGroupExists(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "name", fmt.Sprintf("acctest%s", id)),
+					resource.TestCheckResourceAttrSet(dataSourceName, "object_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMAzureADGroup_byObjectId(t *testing.T) {
+	dataSourceName := "data.azurerm_azuread_group.test"
+	id := uuid.New().String()
+	config := testAccDataSourceAzureRMAzureADGroup_byObjectId(id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMActiveDirectoryGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryGroupExists(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "name", fmt.Sprintf("acctest%s", id)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "object_id", "azurerm_azuread_group.test", "object_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMAzureADGroup_byName(id string) string {
+	template := testAccAzureRMActiveDirectoryGroup_basic(id)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_azuread_group" "test" {
+  name = "${azurerm_azuread_group.test.name}"
+}
+`, template)
+}
+
+func testAccDataSourceAzureRMAzureADGroup_byObjectId(id string) string {
+	template := testAccAzureRMActiveDirectoryGroup_basic(id)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_azuread_group" "test" {
+  object_id = "${azurerm_azuread_group.test.object_id}"
+}
+`, template)
+}
@@ -0,0 +1,93 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmActiveDirectoryUser() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmActiveDirectoryUserRead,
+
+		Schema: map[string]*schema.Schema{
+			"object_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validate.UUID,
+				ConflictsWith: []string{"user_principal_name"},
+			},
+
+			"user_principal_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.NoZeroValues,
+				ConflictsWith: []string{"object_id"},
+			},
+
+			"account_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"mail": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"mail_nickname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmActiveDirectoryUserRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).usersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	// Users can be retrieved using either their Object ID or their User Principal Name
+	var upnOrObjectId string
+	if v, ok := d.GetOk("object_id"); ok {
+		upnOrObjectId = v.(string)
+	} else if v, ok := d.GetOk("user_principal_name"); ok {
+		upnOrObjectId = v.(string)
+	} else {
+		return fmt.Errorf("One of `object_id` or `user_principal_name` must be specified")
+	}
+
+	user, err := client.Get(ctx, upnOrObjectId)
+	if err != nil {
+		if utils.ResponseWasNotFound(user.Response) {
+			return fmt.Errorf("Azure AD User %q was not found!", upnOrObjectId)
+		}
+
+		return fmt.Errorf("Error retrieving Azure AD User %q: %+v", upnOrObjectId, err)
+	}
+
+	if user.ObjectID == nil {
+		return fmt.Errorf("Error retrieving Azure AD User %q: Object ID was nil", upnOrObjectId)
+	}
+
+	d.SetId(*user.ObjectID)
+
+	d.Set("object_id", user.ObjectID)
+	d.Set("user_principal_name", user.UserPrincipalName)
+	d.Set("account_enabled", user.AccountEnabled)
+	d.Set("display_name", user.DisplayName)
+	d.Set("mail", user.Mail)
+	d.Set("mail_nickname", user.MailNickname)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMAzureADUser_byUserPrincipalName(t *testing.T) {
+	dataSourceName := "data.azurerm_azuread_user.test"
+	upnEnvVariable := "ARM_TEST_USER_PRINCIPAL_NAME"
+	upn := os.Getenv(upnEnvVariable)
+	if upn == "" {
+		t.Skipf("Skipping as %q is not specified", upnEnvVariable)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMAzureADUser_byUserPrincipalName(upn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "user_principal_name", upn),
+					resource.TestCheckResourceAttrSet(dataSourceName, "object_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "display_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMAzureADUser_byObjectId(t *testing.T) {
+	dataSourceName := "data.azurerm_azuread_user.test"
+	upnEnvVariable := "ARM_TEST_USER_PRINCIPAL_NAME"
+	upn := os.Getenv(upnEnvVariable)
+	if upn == "" {
+		t.Skipf("Skipping as %q is not specified", upnEnvVariable)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMAzureADUser_byObjectId(upn),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "user_principal_name", upn),
+					resource.TestCheckResourceAttrPair(dataSourceName, "object_id", "data.azurerm_azuread_user.upn", "object_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMAzureADUser_byUserPrincipalName(upn string) string {
+	return fmt.Sprintf(`
+data "azurerm_azuread_user" "test" {
+  user_principal_name = "%s"
+}
+`, upn)
+}
+
+func testAccDataSourceAzureRMAzureADUser_byObjectId(upn string) string {
+	return fmt.Sprintf(`
+data "azurerm_azuread_user" "upn" {
+  user_principal_name = "%s"
+}
+
+data "azurerm_azuread_user" "test" {
+  object_id = "${data.azurerm_azuread_user.upn.object_id}"
+}
+`, upn)
+}
@@ -0,0 +1,172 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmImportDiscovery() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmImportDiscoveryRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"terraform_resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"terraform_resource_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"unsupported_resource_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"import_commands": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"configuration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmImportDiscoveryRead(d *schema.ResourceData, meta interface{}) error {
+	armClient := meta.(*ArmClient)
+	groupsClient := armClient.resourceGroupsClient
+	resourcesClient := armClient.resourcesClient
+	ctx := armClient.StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	found := make([]discoveredResource, 0)
+	if resourceGroup != "" {
+		group, err := groupsClient.Get(ctx, resourceGroup)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Resource Group %q: %+v", resourceGroup, err)
+		}
+		found = append(found, flattenDiscoveredResourceGroup(group))
+
+		resources, err := resourcesClient.ListByResourceGroupComplete(ctx, resourceGroup, "", "", nil)
+		if err != nil {
+			return fmt.Errorf("Error listing Resources in Resource Group %q: %+v", resourceGroup, err)
+		}
+		for resources.NotDone() {
+			found = append(found, flattenDiscoveredResource(resources.Value()))
+			if err := resources.Next(); err != nil {
+				return fmt.Errorf("Error listing Resources in Resource Group %q: %+v", resourceGroup, err)
+			}
+		}
+	} else {
+		groups, err := groupsClient.ListComplete(ctx, "", nil)
+		if err != nil {
+			return fmt.Errorf("Error listing Resource Groups: %+v", err)
+		}
+		for groups.NotDone() {
+			found = append(found, flattenDiscoveredResourceGroup(groups.Value()))
+			if err := groups.Next(); err != nil {
+				return fmt.Errorf("Error listing Resource Groups: %+v", err)
+			}
+		}
+
+		resources, err := resourcesClient.ListComplete(ctx, "", "", nil)
+		if err != nil {
+			return fmt.Errorf("Error listing Resources: %+v", err)
+		}
+		for resources.NotDone() {
+			found = append(found, flattenDiscoveredResource(resources.Value()))
+			if err := resources.Next(); err != nil {
+				return fmt.Errorf("Error listing Resources: %+v", err)
+			}
+		}
+	}
+
+	sortDiscoveredResources(found)
+
+	// only Resources which can be imported are included, everything else is returned as unsupported
+	resourcesMap := Provider().(*schema.Provider).ResourcesMap
+	supported := make([]discoveredResource, 0)
+	unsupported := make([]string, 0)
+	names := make(map[string]map[string]struct{})
+	for _, r := range found {
+		resource, ok := resourcesMap[r.TerraformResourceType]
+		if r.TerraformResourceType == "" || !ok || resource.Importer == nil {
+			log.Printf("[DEBUG] Resource %q (Type %q) can't be imported", r.ID, r.Type)
+			unsupported = append(unsupported, r.ID)
+			continue
+		}
+
+		if _, ok := names[r.TerraformResourceType]; !ok {
+			names[r.TerraformResourceType] = make(map[string]struct{})
+		}
+		r.TerraformResourceName = terraformResourceName(r.Name, names[r.TerraformResourceType])
+		supported = append(supported, r)
+	}
+
+	if resourceGroup != "" {
+		d.SetId(fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", armClient.subscriptionId, resourceGroup))
+	} else {
+		d.SetId(fmt.Sprintf("/subscriptions/%s", armClient.subscriptionId))
+	}
+
+	if err := d.Set("resources", flattenImportDiscoveryResources(supported)); err != nil {
+		return fmt.Errorf("Error setting `resources`: %+v", err)
+	}
+	if err := d.Set("unsupported_resource_ids", unsupported); err != nil {
+		return fmt.Errorf("Error setting `unsupported_resource_ids`: %+v", err)
+	}
+	d.Set("import_commands", importDiscoveryCommands(supported))
+	d.Set("configuration", importDiscoveryConfiguration(supported, resourcesMap))
+
+	return nil
+}
+
+func flattenImportDiscoveryResources(input []discoveredResource) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, r := range input {
+		results = append(results, map[string]interface{}{
+			"id":                      r.ID,
+			"name":                    r.Name,
+			"type":                    r.Type,
+			"terraform_resource_type": r.TerraformResourceType,
+			"terraform_resource_name": r.TerraformResourceName,
+		})
+	}
+
+	return results
+}
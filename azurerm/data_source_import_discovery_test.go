@@ -0,0 +1,71 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+)
+
+func TestUnitDataSourceAzureRMImportDiscovery_resourceGroup(t *testing.T) {
+	dataSourceName := "data.azurerm_import_discovery.test"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	resourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example-resources", fakearm.SubscriptionID)
+	virtualNetworkId := resourceGroupId + "/providers/Microsoft.Network/virtualNetworks/example-network"
+	certificateId := resourceGroupId + "/providers/Microsoft.Web/certificates/example"
+	server.Put(resourceGroupId, map[string]interface{}{"location": "westeurope"})
+	server.Put(virtualNetworkId, map[string]interface{}{"location": "westeurope"})
+	server.Put(certificateId, map[string]interface{}{"location": "westeurope"})
+
+	// another Resource Group, which shouldn't be included
+	server.Put(fmt.Sprintf("/subscriptions/%s/resourceGroups/other", fakearm.SubscriptionID), map[string]interface{}{"location": "westeurope"})
+
+	expectedCommands := fmt.Sprintf(`terraform import azurerm_resource_group.example_resources %q
+terraform import azurerm_virtual_network.example_network %q
+`, resourceGroupId, virtualNetworkId)
+
+	expectedConfiguration := `resource "azurerm_resource_group" "example_resources" {
+  name = "example-resources"
+  location = "westeurope"
+}
+
+resource "azurerm_virtual_network" "example_network" {
+  name = "example-network"
+  resource_group_name = "example-resources"
+  location = "westeurope"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testUnitProviders(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMImportDiscovery_resourceGroup(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.id", resourceGroupId),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.terraform_resource_type", "azurerm_resource_group"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.1.id", virtualNetworkId),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.1.type", "Microsoft.Network/virtualNetworks"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.1.terraform_resource_type", "azurerm_virtual_network"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.1.terraform_resource_name", "example_network"),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported_resource_ids.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "unsupported_resource_ids.0", certificateId),
+					resource.TestCheckResourceAttr(dataSourceName, "import_commands", expectedCommands),
+					resource.TestCheckResourceAttr(dataSourceName, "configuration", expectedConfiguration),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMImportDiscovery_resourceGroup() string {
+	return `
+data "azurerm_import_discovery" "test" {
+  resource_group_name = "example-resources"
+}
+`
+}
@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -43,41 +44,16 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 				Computed: true,
 			},
 
-			"kube_config": {
-				Type:     schema.TypeList,
-				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"host": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"username": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"password": {
-							Type:      schema.TypeString,
-							Computed:  true,
-							Sensitive: true,
-						},
-						"client_certificate": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"client_key": {
-							Type:      schema.TypeString,
-							Computed:  true,
-							Sensitive: true,
-						},
-						"cluster_ca_certificate": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-					},
-				},
+			"kube_admin_config": kubernetesClusterKubeConfigSchema(),
+
+			"kube_admin_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 
+			"kube_config": kubernetesClusterKubeConfigSchema(),
+
 			"kube_config_raw": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -206,6 +182,42 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 				},
 			},
 
+			"role_based_access_control": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"azure_active_directory": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_app_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"server_app_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"tenant_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"addon_profile": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -275,6 +287,16 @@ func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error getting access profile while making Read request on AKS Managed Cluster %q (resource group %q): %+v", name, resourceGroup, err)
 	}
 
+	adminProfile, err := kubernetesClustersClient.GetAccessProfile(ctx, resourceGroup, name, "clusterAdmin")
+	if err != nil {
+		// the admin credentials require additional permissions, which the credentials in use may not have
+		if !utils.ResponseWasForbidden(adminProfile.Response) {
+			return fmt.Errorf("Error getting admin access profile while making Read request on AKS Managed Cluster %q (resource group %q): %+v", name, resourceGroup, err)
+		}
+
+		log.Printf("[DEBUG] Not authorized to retrieve the admin access profile for AKS Managed Cluster %q (resource group %q) - `kube_admin_config` will be empty: %+v", name, resourceGroup, err)
+	}
+
 	d.SetId(*resp.ID)
 
 	d.Set("name", resp.Name)
@@ -309,6 +331,11 @@ func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}
 			return fmt.Errorf("Error setting `network_profile`: %+v", err)
 		}
 
+		roleBasedAccessControl := flattenKubernetesClusterDataSourceRoleBasedAccessControl(props)
+		if err := d.Set("role_based_access_control", roleBasedAccessControl); err != nil {
+			return fmt.Errorf("Error setting `role_based_access_control`: %+v", err)
+		}
+
 		servicePrincipal := flattenKubernetesClusterDataSourceServicePrincipalProfile(props.ServicePrincipalProfile)
 		if err := d.Set("service_principal", servicePrincipal); err != nil {
 			return fmt.Errorf("Error setting `service_principal`: %+v", err)
@@ -322,6 +349,13 @@ func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error setting `kube_config`: %+v", err)
 	}
 
+	kubeAdminConfigRaw, kubeAdminConfig := flattenKubernetesClusterDataSourceAccessProfile(&adminProfile)
+	d.Set("kube_admin_config_raw", kubeAdminConfigRaw)
+
+	if err := d.Set("kube_admin_config", kubeAdminConfig); err != nil {
+		return fmt.Errorf("Error setting `kube_admin_config`: %+v", err)
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -398,6 +432,39 @@ func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]containerservi
 	return agentPoolProfiles
 }
 
+func flattenKubernetesClusterDataSourceRoleBasedAccessControl(input *containerservice.ManagedClusterProperties) []interface{} {
+	enabled := false
+	if input.EnableRBAC != nil {
+		enabled = *input.EnableRBAC
+	}
+
+	azureActiveDirectory := make([]interface{}, 0)
+	if profile := input.AadProfile; profile != nil {
+		values := make(map[string]interface{})
+
+		if profile.ClientAppID != nil {
+			values["client_app_id"] = *profile.ClientAppID
+		}
+
+		if profile.ServerAppID != nil {
+			values["server_app_id"] = *profile.ServerAppID
+		}
+
+		if profile.TenantID != nil {
+			values["tenant_id"] = *profile.TenantID
+		}
+
+		azureActiveDirectory = append(azureActiveDirectory, values)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":                enabled,
+			"azure_active_directory": azureActiveDirectory,
+		},
+	}
+}
+
 func flattenKubernetesClusterDataSourceServicePrincipalProfile(profile *containerservice.ManagedClusterServicePrincipalProfile) []interface{} {
 	if profile == nil {
 		return []interface{}{}
@@ -445,6 +512,8 @@ func flattenKubernetesClusterDataSourceKubeConfig(config kubernetes.KubeConfig)
 	values["client_certificate"] = user.ClientCertificteData
 	values["client_key"] = user.ClientKeyData
 	values["cluster_ca_certificate"] = cluster.ClusterAuthorityData
+	values["auth_provider"] = flattenKubernetesClusterKubeConfigAuthProvider(config)
+	values["exec"] = flattenKubernetesClusterKubeConfigExec(config)
 
 	return []interface{}{values}
 }
@@ -0,0 +1,140 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmTemplateDeploymentRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// when omitted the Template Deployment is looked up at the Subscription scope
+			"resource_group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"provisioning_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"output_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).deploymentsAtScopeClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	scope := fmt.Sprintf("/subscriptions/%s", meta.(*ArmClient).subscriptionId)
+	description := fmt.Sprintf("Template Deployment %q (Subscription %q)", name, meta.(*ArmClient).subscriptionId)
+	if resourceGroup != "" {
+		scope = fmt.Sprintf("%s/resourceGroups/%s", scope, resourceGroup)
+		description = fmt.Sprintf("Template Deployment %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	resp, err := client.Get(ctx, scope, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: %s was not found", description)
+		}
+		return fmt.Errorf("Error retrieving %s: %+v", description, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Error retrieving %s: ID was nil", description)
+	}
+	d.SetId(*resp.ID)
+
+	if props := resp.Properties; props != nil {
+		outputs, outputsJson, err := flattenTemplateDeploymentOutputs(props.Outputs)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("outputs", outputs); err != nil {
+			return fmt.Errorf("Error setting `outputs`: %+v", err)
+		}
+		d.Set("outputs_json", outputsJson)
+		d.Set("provisioning_state", props.ProvisioningState)
+
+		timestamp := ""
+		if props.Timestamp != nil {
+			timestamp = props.Timestamp.Format(time.RFC3339)
+		}
+		d.Set("timestamp", timestamp)
+	}
+
+	operations, err := client.ListOperations(ctx, scope, name)
+	if err != nil {
+		return fmt.Errorf("Error listing Operations for %s: %+v", description, err)
+	}
+
+	outputResources := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, operation := range operations {
+		props := operation.Properties
+		if props == nil || props.TargetResource == nil || props.TargetResource.ID == nil {
+			continue
+		}
+
+		// nested deployments are an implementation detail of the Template, rather than an output of it
+		if props.TargetResource.ResourceType != nil && strings.EqualFold(*props.TargetResource.ResourceType, "Microsoft.Resources/deployments") {
+			continue
+		}
+
+		if props.ProvisioningState == nil || !strings.EqualFold(*props.ProvisioningState, "Succeeded") {
+			continue
+		}
+
+		id := *props.TargetResource.ID
+		if seen[strings.ToLower(id)] {
+			continue
+		}
+		seen[strings.ToLower(id)] = true
+		outputResources = append(outputResources, id)
+	}
+
+	if err := d.Set("output_resources", outputResources); err != nil {
+		return fmt.Errorf("Error setting `output_resources`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+)
+
+func TestUnitDataSourceAzureRMTemplateDeployment_scopes(t *testing.T) {
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	subscriptionId := fmt.Sprintf("/subscriptions/%s", fakearm.SubscriptionID)
+	resourceGroupId := subscriptionId + "/resourceGroups/example-resources"
+	storageAccountId := resourceGroupId + "/providers/Microsoft.Storage/storageAccounts/examplestorage"
+	resourceGroupDeploymentId := resourceGroupId + "/providers/Microsoft.Resources/deployments/example-deployment"
+	server.Put(resourceGroupDeploymentId, map[string]interface{}{
+		"properties": map[string]interface{}{
+			"timestamp": "2019-03-01T10:15:30Z",
+			"outputs": map[string]interface{}{
+				"storageAccountName": map[string]interface{}{
+					"type":  "String",
+					"value": "examplestorage",
+				},
+				"tags": map[string]interface{}{
+					"type": "Object",
+					"value": map[string]interface{}{
+						"environment": "Production",
+					},
+				},
+			},
+		},
+	})
+	server.Put(resourceGroupDeploymentId+"/operations/1", map[string]interface{}{
+		"properties": map[string]interface{}{
+			"targetResource": map[string]interface{}{
+				"id":           storageAccountId,
+				"resourceType": "Microsoft.Storage/storageAccounts",
+			},
+		},
+	})
+	server.Put(resourceGroupDeploymentId+"/operations/2", map[string]interface{}{
+		"properties": map[string]interface{}{
+			"targetResource": map[string]interface{}{
+				"id":           resourceGroupId + "/providers/Microsoft.Resources/deployments/nested",
+				"resourceType": "Microsoft.Resources/deployments",
+			},
+		},
+	})
+
+	subscriptionDeploymentId := subscriptionId + "/providers/Microsoft.Resources/deployments/example-deployment"
+	server.Put(subscriptionDeploymentId, map[string]interface{}{
+		"properties": map[string]interface{}{
+			"timestamp": "2019-03-02T08:00:00Z",
+			"outputs": map[string]interface{}{
+				"resourceGroupId": map[string]interface{}{
+					"type":  "String",
+					"value": resourceGroupId,
+				},
+			},
+		},
+	})
+	server.Put(subscriptionDeploymentId+"/operations/1", map[string]interface{}{
+		"properties": map[string]interface{}{
+			"targetResource": map[string]interface{}{
+				"id":           resourceGroupId,
+				"resourceType": "Microsoft.Resources/resourceGroups",
+			},
+		},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testUnitProviders(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMTemplateDeployment_scopes(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azurerm_template_deployment.resource_group", "id", resourceGroupDeploymentId),
+					resource.TestCheckResourceAttr("data.azurerm_template_deployment.resource_group", "provisioning_state", "Succeeded"),
+					resource.TestCheckResourceAttr("data.azurerm_template_deployment.resource_group", "timestamp", "2019-03-01T10:15:30Z"),
+					resource.TestCheckResourceAttr("data.azurerm_template_deployment.resource_group", "outputs.%", "1"),
+					resource.TestCheckResourceAttr("data.azurerm_template_deployment.resource_group", "outputs.storageAccountName", "examplestorage"),
+					resource.TestCheckResourceAttr("data.azurerm_template_deployment.resource_group", "outputs_json", `{"storageAccountName":"examplestorage","tags":{"environment":"Production"}}`),
+					resource.TestCheckResourceAttr("data.azurerm_template_deployment.resource_group", "output_resources.#", "1"),
+					resource.TestCheckResourceAttr("data.azurerm_template_deployment.resource_group", "output_resources.0", storageAccountId),
+					resource.TestCheckResourceAttr("data.azurerm_template_deployment.subscription", "id", subscriptionDeploymentId),
+					resource.TestCheckResourceAttr("data.azurerm_template_deployment.subscription", "timestamp", "2019-03-02T08:00:00Z"),
+					resource.TestCheckResourceAttr("data.azurerm_template_deployment.subscription", "outputs.resourceGroupId", resourceGroupId),
+					resource.TestCheckResourceAttr("data.azurerm_template_deployment.subscription", "output_resources.#", "1"),
+					resource.TestCheckResourceAttr("data.azurerm_template_deployment.subscription", "output_resources.0", resourceGroupId),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataSourceAzureRMTemplateDeployment_notFound(t *testing.T) {
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testUnitProviders(server),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourceAzureRMTemplateDeployment_scopes(),
+				ExpectError: regexp.MustCompile("was not found"),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMTemplateDeployment_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_template_deployment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMTemplateDeployment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "azurerm_template_deployment.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "provisioning_state", "Succeeded"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "timestamp"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "outputs.storageAccountName", "azurerm_template_deployment.test", "outputs.storageAccountName"),
+					resource.TestCheckResourceAttr(dataSourceName, "output_resources.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMTemplateDeployment_scopes() string {
+	return `
+data "azurerm_template_deployment" "resource_group" {
+  name                = "example-deployment"
+  resource_group_name = "example-resources"
+}
+
+data "azurerm_template_deployment" "subscription" {
+  name = "example-deployment"
+}
+`
+}
+
+func testAccDataSourceAzureRMTemplateDeployment_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "variables": {
+    "storageAccountName": "[concat('acctest', uniqueString(resourceGroup().id))]"
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Storage/storageAccounts",
+      "name": "[variables('storageAccountName')]",
+      "apiVersion": "2015-06-15",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "accountType": "Standard_LRS"
+      }
+    }
+  ],
+  "outputs": {
+    "storageAccountName": {
+      "type": "string",
+      "value": "[variables('storageAccountName')]"
+    }
+  }
+}
+DEPLOY
+}
+
+data "azurerm_template_deployment" "test" {
+  name                = "${azurerm_template_deployment.test.name}"
+  resource_group_name = "${azurerm_template_deployment.test.resource_group_name}"
+}
+`, rInt, location, rInt)
+}
@@ -0,0 +1,235 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// NAT Rule Collections, and FQDN Tags, Target FQDNs and the MSSQL protocol for Application Rules are only available
+// from version 2019-04-01 of the Network API. The SDK package for this version polls long-running operations using
+// `Future.DoneWithContext`, which the vendored version of go-autorest doesn't support - so this is a minimal client
+// for Azure Firewalls, which is used for both the Rule Collections and the Firewall itself (so that the existing
+// Rule Collections can be sent back when the Firewall is updated). The properties of the Firewall which aren't
+// managed using this client are retained as-is, so that they're sent back to the API unchanged.
+const azureFirewallRuleCollectionsAPIVersion = "2019-04-01"
+
+type azureFirewallRuleCollectionsClient struct {
+	autorest.Client
+	BaseURI        string
+	SubscriptionID string
+}
+
+type azureFirewallWithRuleCollections struct {
+	autorest.Response `json:"-"`
+
+	ID         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Location   *string                    `json:"location,omitempty"`
+	Tags       map[string]*string         `json:"tags,omitempty"`
+	Zones      *[]string                  `json:"zones,omitempty"`
+	Properties map[string]json.RawMessage `json:"properties,omitempty"`
+}
+
+type azureFirewallRCAction struct {
+	Type string `json:"type,omitempty"`
+}
+
+type azureFirewallApplicationRuleCollection struct {
+	ID         *string                                           `json:"id,omitempty"`
+	Name       *string                                           `json:"name,omitempty"`
+	Properties *azureFirewallApplicationRuleCollectionProperties `json:"properties,omitempty"`
+}
+
+type azureFirewallApplicationRuleCollectionProperties struct {
+	Priority *int32                          `json:"priority,omitempty"`
+	Action   *azureFirewallRCAction          `json:"action,omitempty"`
+	Rules    *[]azureFirewallApplicationRule `json:"rules,omitempty"`
+}
+
+type azureFirewallApplicationRule struct {
+	Name            *string                                 `json:"name,omitempty"`
+	Description     *string                                 `json:"description,omitempty"`
+	SourceAddresses *[]string                               `json:"sourceAddresses,omitempty"`
+	Protocols       *[]azureFirewallApplicationRuleProtocol `json:"protocols,omitempty"`
+	TargetFqdns     *[]string                               `json:"targetFqdns,omitempty"`
+	FqdnTags        *[]string                               `json:"fqdnTags,omitempty"`
+}
+
+type azureFirewallApplicationRuleProtocol struct {
+	ProtocolType string `json:"protocolType,omitempty"`
+	Port         *int32 `json:"port,omitempty"`
+}
+
+type azureFirewallNatRuleCollection struct {
+	ID         *string                                   `json:"id,omitempty"`
+	Name       *string                                   `json:"name,omitempty"`
+	Properties *azureFirewallNatRuleCollectionProperties `json:"properties,omitempty"`
+}
+
+type azureFirewallNatRuleCollectionProperties struct {
+	Priority *int32                  `json:"priority,omitempty"`
+	Action   *azureFirewallRCAction  `json:"action,omitempty"`
+	Rules    *[]azureFirewallNatRule `json:"rules,omitempty"`
+}
+
+type azureFirewallNatRule struct {
+	Name                 *string   `json:"name,omitempty"`
+	Description          *string   `json:"description,omitempty"`
+	SourceAddresses      *[]string `json:"sourceAddresses,omitempty"`
+	DestinationAddresses *[]string `json:"destinationAddresses,omitempty"`
+	DestinationPorts     *[]string `json:"destinationPorts,omitempty"`
+	Protocols            *[]string `json:"protocols,omitempty"`
+	TranslatedAddress    *string   `json:"translatedAddress,omitempty"`
+	TranslatedPort       *string   `json:"translatedPort,omitempty"`
+}
+
+type azureFirewallNetworkRuleCollection struct {
+	ID         *string                                       `json:"id,omitempty"`
+	Name       *string                                       `json:"name,omitempty"`
+	Properties *azureFirewallNetworkRuleCollectionProperties `json:"properties,omitempty"`
+}
+
+type azureFirewallNetworkRuleCollectionProperties struct {
+	Priority *int32                      `json:"priority,omitempty"`
+	Action   *azureFirewallRCAction      `json:"action,omitempty"`
+	Rules    *[]azureFirewallNetworkRule `json:"rules,omitempty"`
+}
+
+type azureFirewallNetworkRule struct {
+	Name                 *string   `json:"name,omitempty"`
+	Description          *string   `json:"description,omitempty"`
+	Protocols            *[]string `json:"protocols,omitempty"`
+	SourceAddresses      *[]string `json:"sourceAddresses,omitempty"`
+	DestinationAddresses *[]string `json:"destinationAddresses,omitempty"`
+	DestinationPorts     *[]string `json:"destinationPorts,omitempty"`
+}
+
+func (firewall azureFirewallWithRuleCollections) ApplicationRuleCollections() ([]azureFirewallApplicationRuleCollection, error) {
+	collections := make([]azureFirewallApplicationRuleCollection, 0)
+	err := firewall.unmarshalProperty("applicationRuleCollections", &collections)
+	return collections, err
+}
+
+func (firewall *azureFirewallWithRuleCollections) SetApplicationRuleCollections(collections []azureFirewallApplicationRuleCollection) error {
+	return firewall.marshalProperty("applicationRuleCollections", collections)
+}
+
+func (firewall azureFirewallWithRuleCollections) NatRuleCollections() ([]azureFirewallNatRuleCollection, error) {
+	collections := make([]azureFirewallNatRuleCollection, 0)
+	err := firewall.unmarshalProperty("natRuleCollections", &collections)
+	return collections, err
+}
+
+func (firewall *azureFirewallWithRuleCollections) SetNatRuleCollections(collections []azureFirewallNatRuleCollection) error {
+	return firewall.marshalProperty("natRuleCollections", collections)
+}
+
+func (firewall azureFirewallWithRuleCollections) NetworkRuleCollections() ([]azureFirewallNetworkRuleCollection, error) {
+	collections := make([]azureFirewallNetworkRuleCollection, 0)
+	err := firewall.unmarshalProperty("networkRuleCollections", &collections)
+	return collections, err
+}
+
+func (firewall *azureFirewallWithRuleCollections) SetNetworkRuleCollections(collections []azureFirewallNetworkRuleCollection) error {
+	return firewall.marshalProperty("networkRuleCollections", collections)
+}
+
+func (firewall azureFirewallWithRuleCollections) unmarshalProperty(key string, v interface{}) error {
+	raw, ok := firewall.Properties[key]
+	if !ok || string(raw) == "null" {
+		return nil
+	}
+
+	return json.Unmarshal(raw, v)
+}
+
+func (firewall *azureFirewallWithRuleCollections) marshalProperty(key string, v interface{}) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	if firewall.Properties == nil {
+		firewall.Properties = make(map[string]json.RawMessage)
+	}
+	firewall.Properties[key] = raw
+	return nil
+}
+
+func newAzureFirewallRuleCollectionsClientWithBaseURI(baseURI string, subscriptionID string) azureFirewallRuleCollectionsClient {
+	return azureFirewallRuleCollectionsClient{
+		Client:         autorest.NewClientWithUserAgent(""),
+		BaseURI:        baseURI,
+		SubscriptionID: subscriptionID,
+	}
+}
+
+func (client azureFirewallRuleCollectionsClient) CreateOrUpdate(ctx context.Context, resourceGroupName, firewallName string, parameters azureFirewallWithRuleCollections) (azure.Future, error) {
+	req, err := client.preparer(ctx, resourceGroupName, firewallName,
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "azurerm.azureFirewallRuleCollectionsClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "azurerm.azureFirewallRuleCollectionsClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	if err := autorest.Respond(resp, azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated)); err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "azurerm.azureFirewallRuleCollectionsClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return azure.NewFutureFromResponse(resp)
+}
+
+func (client azureFirewallRuleCollectionsClient) Get(ctx context.Context, resourceGroupName, firewallName string) (result azureFirewallWithRuleCollections, err error) {
+	req, err := client.preparer(ctx, resourceGroupName, firewallName, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.azureFirewallRuleCollectionsClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azurerm.azureFirewallRuleCollectionsClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.azureFirewallRuleCollectionsClient", "Get", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (client azureFirewallRuleCollectionsClient) preparer(ctx context.Context, resourceGroupName, firewallName string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"azureFirewallName": autorest.Encode("path", firewallName),
+		"resourceGroupName": autorest.Encode("path", resourceGroupName),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": azureFirewallRuleCollectionsAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/azureFirewalls/{azureFirewallName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
@@ -0,0 +1,35 @@
+package authentication
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"golang.org/x/crypto/pkcs12"
+)
+
+// LoadClientCertificate reads the PFX file specified in ClientCertPath and returns the
+// Certificate and RSA Private Key it contains, decrypted using ClientCertPassword
+func (c *Config) LoadClientCertificate() (*x509.Certificate, *rsa.PrivateKey, error) {
+	data, err := ioutil.ReadFile(c.ClientCertPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error reading Client Certificate %q: %v", c.ClientCertPath, err)
+	}
+
+	return decodePkcs12(data, c.ClientCertPassword)
+}
+
+func decodePkcs12(data []byte, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	privateKey, certificate, err := pkcs12.Decode(data, password)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error decoding the PKCS#12 Client Certificate: %v", err)
+	}
+
+	rsaPrivateKey, isRsaKey := privateKey.(*rsa.PrivateKey)
+	if !isRsaKey {
+		return nil, nil, fmt.Errorf("PKCS#12 Client Certificate did not contain an RSA Private Key")
+	}
+
+	return certificate, rsaPrivateKey, nil
+}
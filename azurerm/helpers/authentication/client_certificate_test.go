@@ -0,0 +1,62 @@
+package authentication
+
+import (
+	"testing"
+)
+
+func TestAzureLoadClientCertificate(t *testing.T) {
+	cases := []struct {
+		Description string
+		Path        string
+		Password    string
+		ExpectError bool
+	}{
+		{
+			Description: "Missing File",
+			Path:        "testdata/does_not_exist.pfx",
+			Password:    "P@ssw0rd",
+			ExpectError: true,
+		},
+		{
+			Description: "Not a PKCS#12 File",
+			Path:        "config.go",
+			Password:    "P@ssw0rd",
+			ExpectError: true,
+		},
+		{
+			Description: "Incorrect Password",
+			Path:        "testdata/client_certificate.pfx",
+			Password:    "Does Hammer Time have Daylight Savings Time?",
+			ExpectError: true,
+		},
+		{
+			Description: "Valid Certificate",
+			Path:        "testdata/client_certificate.pfx",
+			Password:    "P@ssw0rd",
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		config := Config{
+			ClientCertPath:     v.Path,
+			ClientCertPassword: v.Password,
+		}
+		certificate, privateKey, err := config.LoadClientCertificate()
+
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+
+		if certificate == nil || privateKey == nil {
+			t.Fatalf("Expected a Certificate and Private Key for %q - but got nil", v.Description)
+		}
+	}
+}
@@ -2,9 +2,10 @@ package authentication
 
 import (
 	"fmt"
-
 	"log"
+	"time"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure/cli"
 )
@@ -22,9 +23,21 @@ type Config struct {
 	SkipCredentialsValidation bool
 	SkipProviderRegistration  bool
 
+	// Retries
+	MaxRetries   int
+	MaxRetryWait time.Duration
+
+	// Custom Resource Manager Endpoint & Authorizer, used to connect to a local stand-in for Azure
+	CustomResourceManagerEndpoint string
+	CustomAuthorizer              autorest.Authorizer
+
 	// Service Principal Auth
 	ClientSecret string
 
+	// Service Principal (Client Certificate) Auth
+	ClientCertPath     string
+	ClientCertPassword string
+
 	// Bearer Auth
 	AccessToken  *adal.Token
 	IsCloudShell bool
@@ -2,6 +2,8 @@ package authentication
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"github.com/hashicorp/go-multierror"
 )
@@ -50,6 +52,30 @@ func (c *Config) ValidateServicePrincipal() error {
 	return err.ErrorOrNil()
 }
 
+func (c *Config) ValidateServicePrincipalCertificate() error {
+	var err *multierror.Error
+
+	if c.SubscriptionID == "" {
+		err = multierror.Append(err, fmt.Errorf("Subscription ID must be configured for the AzureRM provider"))
+	}
+	if c.ClientID == "" {
+		err = multierror.Append(err, fmt.Errorf("Client ID must be configured for the AzureRM provider"))
+	}
+	if c.ClientCertPath == "" {
+		err = multierror.Append(err, fmt.Errorf("Client Certificate Path must be configured for the AzureRM provider"))
+	} else if strings.ToLower(filepath.Ext(c.ClientCertPath)) != ".pfx" {
+		err = multierror.Append(err, fmt.Errorf("Client Certificate Path must be a path to a PFX (PKCS#12) file"))
+	}
+	if c.TenantID == "" {
+		err = multierror.Append(err, fmt.Errorf("Tenant ID must be configured for the AzureRM provider"))
+	}
+	if c.Environment == "" {
+		err = multierror.Append(err, fmt.Errorf("Environment must be configured for the AzureRM provider"))
+	}
+
+	return err.ErrorOrNil()
+}
+
 func (c *Config) ValidateMsi() error {
 	var err *multierror.Error
 
@@ -0,0 +1,149 @@
+package fakearm
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// canonicalID returns the Resource ID with the casing used by the real API, since the Azure SDK
+// uses a mixture of casings (e.g. `resourcegroups` and `resourceGroups`) within the request URI's
+func canonicalID(id string) string {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	for i, v := range segments {
+		switch {
+		case i == 0 && strings.EqualFold(v, "subscriptions"):
+			segments[i] = "subscriptions"
+		case i == 2 && strings.EqualFold(v, "resourceGroups"):
+			segments[i] = "resourceGroups"
+		case strings.EqualFold(v, "providers"):
+			segments[i] = "providers"
+		case i >= 2 && strings.EqualFold(segments[i-2], "providers") && strings.EqualFold(v, "dnsZones"):
+			// the DNS API returns the ID's of DNS Zones (and the Record Sets within them) in lower-case
+			segments[i] = "dnszones"
+		}
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+// resourceKey returns the key used to store the Resource, since Resource ID's are case-insensitive
+func resourceKey(id string) string {
+	return strings.ToLower(canonicalID(id))
+}
+
+func longRunningKey(resourceType, method string) string {
+	return strings.ToLower(method + " " + resourceType)
+}
+
+func actionKey(resourceType, action string) string {
+	return strings.ToLower(resourceType + "/" + action)
+}
+
+// typeSegments returns the Resource Provider Namespace and the segments of the ID following it,
+// which alternate between a Type and a Name (e.g. `virtualNetworks`, `example`, `subnets`, `example`)
+func typeSegments(id string) (string, []string) {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	for i := len(segments) - 2; i >= 0; i-- {
+		if strings.EqualFold(segments[i], "providers") {
+			return segments[i+1], segments[i+2:]
+		}
+	}
+
+	return "Microsoft.Resources", segments
+}
+
+// resourceType returns the Resource Type for the specified ID, e.g. `Microsoft.Network/virtualNetworks/subnets`
+func resourceType(id string) string {
+	namespace, segments := typeSegments(canonicalID(id))
+
+	types := make([]string, 0)
+	for i := 0; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+
+	// a Resource Group is the only type which isn't a subscription-level type within the Resources namespace
+	if namespace == "Microsoft.Resources" && len(types) > 1 {
+		types = types[len(types)-1:]
+	}
+
+	return namespace + "/" + strings.Join(types, "/")
+}
+
+// isCollection returns whether the path refers to a list of Resources (rather than a single Resource)
+func isCollection(path string) bool {
+	_, segments := typeSegments(path)
+	return len(segments)%2 == 1
+}
+
+// genericResourcesScope returns the Subscription / Resource Group ID when the path refers to the generic list of
+// Resources within it (e.g. `/subscriptions/{id}/resourceGroups/{name}/resources`)
+func genericResourcesScope(path string) (string, bool) {
+	segments := strings.Split(strings.Trim(canonicalID(path), "/"), "/")
+	if len(segments) != 3 && len(segments) != 5 {
+		return "", false
+	}
+	if segments[len(segments)-1] != "resources" || (len(segments) == 5 && segments[2] != "resourceGroups") {
+		return "", false
+	}
+
+	return "/" + strings.Join(segments[:len(segments)-1], "/"), true
+}
+
+// resourceGroupID returns the ID of the Resource Group containing the specified Resource, if any
+func resourceGroupID(id string) string {
+	segments := strings.Split(strings.Trim(canonicalID(id), "/"), "/")
+	if len(segments) < 4 || segments[2] != "resourceGroups" {
+		return ""
+	}
+
+	return "/" + strings.Join(segments[:4], "/")
+}
+
+// normalizeResource populates the fields the real API returns for every Resource
+func normalizeResource(id string, resource map[string]interface{}) map[string]interface{} {
+	id = canonicalID(id)
+
+	resource["id"] = id
+	resource["name"] = id[strings.LastIndex(id, "/")+1:]
+	resource["type"] = resourceType(id)
+
+	properties, ok := resource["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+		resource["properties"] = properties
+	}
+	properties["provisioningState"] = "Succeeded"
+
+	return resource
+}
+
+// mergeResource merges the fields specified in a PATCH into the existing Resource
+func mergeResource(existing, patch map[string]interface{}) map[string]interface{} {
+	for k, v := range patch {
+		existingValue, existingIsMap := existing[k].(map[string]interface{})
+		patchValue, patchIsMap := v.(map[string]interface{})
+		if existingIsMap && patchIsMap {
+			existing[k] = mergeResource(existingValue, patchValue)
+			continue
+		}
+
+		existing[k] = v
+	}
+
+	return existing
+}
+
+// copyResource returns a deep copy of the Resource, so that it can't be modified outside of the Server
+func copyResource(resource map[string]interface{}) map[string]interface{} {
+	b, err := json.Marshal(resource)
+	if err != nil {
+		panic(err)
+	}
+
+	output := make(map[string]interface{})
+	if err := json.Unmarshal(b, &output); err != nil {
+		panic(err)
+	}
+
+	return output
+}
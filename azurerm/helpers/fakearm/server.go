@@ -0,0 +1,510 @@
+package fakearm
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// SubscriptionID is the ID of the Subscription which should be used with the fake Resource Manager API
+	SubscriptionID = "00000000-0000-0000-0000-000000000000"
+
+	// TenantID is the ID of the Tenant which should be used with the fake Resource Manager API
+	TenantID = "00000000-0000-0000-0000-000000000000"
+
+	operationsPath = "/fakearm/operations/"
+)
+
+// ActionFunc handles a POST to an Action on a Resource (for example `listKeys`) - returning the
+// HTTP Status Code and the (JSON serializable) Response Body
+type ActionFunc func(resource map[string]interface{}) (int, interface{})
+
+// RequestActionFunc handles a POST to an Action which doesn't require the Resource to exist (for example `validate`),
+// being passed the ID of the Resource and the Request Body - and returning the HTTP Status Code and the (JSON
+// serializable) Response Body
+type RequestActionFunc func(id string, body map[string]interface{}) (int, interface{})
+
+// ComputeFunc populates the fields of a Resource which are computed by the API when it's created/updated
+type ComputeFunc func(resource map[string]interface{})
+
+// SideEffectFunc is invoked once a Resource has been created/updated, and stores (via `put`) any other Resources
+// which the API creates as a result - for example the Resources deployed by a Template Deployment
+type SideEffectFunc func(resource map[string]interface{}, put func(id string, resource map[string]interface{}))
+
+// Server is a fake implementation of the Azure Resource Manager API, which can be used to test Resources
+// end-to-end without an Azure Subscription. Resources are stored in-memory, keyed by their Resource ID:
+//
+// * PUT stores (or replaces) the Resource, PATCH merges into an existing Resource
+// * GET returns either a single Resource or a list of Resources within a collection, HEAD checks for existence
+// * DELETE removes the Resource (and any nested Resources)
+// * POST invokes an Action registered via `Action` (or `RequestAction`)
+//
+// Operations registered as long running via `LongRunning` complete asynchronously, such that the Azure
+// SDK has to poll the `Azure-AsyncOperation` endpoint until the operation has completed.
+type Server struct {
+	*httptest.Server
+
+	// PollsBeforeCompletion is the number of times a long running operation reports that it's
+	// `InProgress` before it completes
+	PollsBeforeCompletion int
+
+	lock        sync.Mutex
+	resources   map[string]map[string]interface{}
+	operations  map[string]int
+	longRunning map[string]int
+	actions     map[string]ActionFunc
+	requests    map[string]RequestActionFunc
+	computed    map[string]ComputeFunc
+	sideEffects map[string]SideEffectFunc
+	operationId int
+}
+
+// NewServer starts and returns a new fake Resource Manager API, which should be closed once finished with
+func NewServer() *Server {
+	s := &Server{
+		PollsBeforeCompletion: 1,
+		resources:             make(map[string]map[string]interface{}),
+		operations:            make(map[string]int),
+		longRunning:           make(map[string]int),
+		actions:               make(map[string]ActionFunc),
+		requests:              make(map[string]RequestActionFunc),
+		computed:              make(map[string]ComputeFunc),
+		sideEffects:           make(map[string]SideEffectFunc),
+	}
+
+	// match the behaviour of the real API for the Resources which the Azure SDK polls
+	s.LongRunning("Microsoft.Resources/resourceGroups", http.MethodDelete, http.StatusAccepted)
+	s.LongRunning("Microsoft.Network/virtualNetworks", http.MethodPut, http.StatusCreated)
+	s.LongRunning("Microsoft.Network/virtualNetworks", http.MethodDelete, http.StatusAccepted)
+	s.LongRunning("Microsoft.Network/virtualNetworks/subnets", http.MethodPut, http.StatusCreated)
+	s.LongRunning("Microsoft.Network/virtualNetworks/subnets", http.MethodDelete, http.StatusAccepted)
+	s.LongRunning("Microsoft.Network/privateDnsZones", http.MethodPut, http.StatusAccepted)
+	s.LongRunning("Microsoft.Network/privateDnsZones", http.MethodDelete, http.StatusAccepted)
+	s.LongRunning("Microsoft.Network/privateDnsZones/virtualNetworkLinks", http.MethodPut, http.StatusAccepted)
+	s.LongRunning("Microsoft.Network/privateDnsZones/virtualNetworkLinks", http.MethodDelete, http.StatusAccepted)
+	s.LongRunning("Microsoft.Storage/storageAccounts", http.MethodPut, http.StatusAccepted)
+	s.Action("Microsoft.Storage/storageAccounts", "listKeys", listStorageAccountKeys)
+	s.Computed("Microsoft.Storage/storageAccounts", computeStorageAccount)
+	s.RequestAction("Microsoft.Resources/deployments", "validate", validateDeployment)
+
+	s.Server = httptest.NewServer(s)
+	return s
+}
+
+// LongRunning configures requests using the specified HTTP Method against the specified Resource Type
+// (e.g. `Microsoft.Network/virtualNetworks`) to complete asynchronously, returning the specified Status Code
+func (s *Server) LongRunning(resourceType, method string, statusCode int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.longRunning[longRunningKey(resourceType, method)] = statusCode
+}
+
+// Action registers a handler for a POST to the specified Action (e.g. `listKeys`) on the specified Resource Type
+func (s *Server) Action(resourceType, action string, handler ActionFunc) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.actions[actionKey(resourceType, action)] = handler
+}
+
+// RequestAction registers a handler for a POST to the specified Action (e.g. `validate`) on the specified Resource
+// Type, which is invoked regardless of whether the Resource exists - providing its Resource Group exists
+func (s *Server) RequestAction(resourceType, action string, handler RequestActionFunc) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.requests[actionKey(resourceType, action)] = handler
+}
+
+// Computed registers a function which populates the computed fields of the specified Resource Type
+func (s *Server) Computed(resourceType string, compute ComputeFunc) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.computed[strings.ToLower(resourceType)] = compute
+}
+
+// SideEffect registers a function which is invoked once a Resource of the specified Resource Type has been created/updated
+func (s *Server) SideEffect(resourceType string, sideEffect SideEffectFunc) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.sideEffects[strings.ToLower(resourceType)] = sideEffect
+}
+
+// Get returns a copy of the Resource with the specified ID, if it exists
+func (s *Server) Get(id string) (map[string]interface{}, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	resource, ok := s.resources[resourceKey(id)]
+	if !ok {
+		return nil, false
+	}
+
+	return copyResource(resource), true
+}
+
+// Put stores the specified Resource, which allows a test to depend on a Resource which already exists
+func (s *Server) Put(id string, resource map[string]interface{}) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.resources[resourceKey(id)] = normalizeResource(id, copyResource(resource))
+}
+
+// Delete removes the Resource with the specified ID (and any nested Resources), which allows a test to simulate
+// a Resource being deleted outside of Terraform
+func (s *Server) Delete(id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.deleteResource(resourceKey(id))
+}
+
+// ResourceIDs returns the (sorted) ID's of all of the Resources which exist
+func (s *Server) ResourceIDs() []string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	ids := make([]string, 0, len(s.resources))
+	for _, v := range s.resources {
+		ids = append(ids, v["id"].(string))
+	}
+	sort.Strings(ids)
+
+	return ids
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	if strings.HasPrefix(path, operationsPath) && r.Method == http.MethodGet {
+		s.serveOperation(w, strings.TrimPrefix(path, operationsPath))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if isCollection(path) {
+			s.serveList(w, path)
+		} else {
+			s.serveGet(w, path)
+		}
+	case http.MethodHead:
+		if _, ok := s.resources[resourceKey(path)]; ok {
+			w.WriteHeader(http.StatusNoContent)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case http.MethodPut, http.MethodPatch:
+		s.servePut(w, r, path)
+	case http.MethodDelete:
+		s.serveDelete(w, path)
+	case http.MethodPost:
+		s.serveAction(w, r, path)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", fmt.Sprintf("The method %q is not supported", r.Method))
+	}
+}
+
+func (s *Server) serveGet(w http.ResponseWriter, id string) {
+	resource, ok := s.resources[resourceKey(id)]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resource)
+}
+
+func (s *Server) serveList(w http.ResponseWriter, collection string) {
+	prefix := resourceKey(collection) + "/"
+
+	keys := make([]string, 0)
+	if scope, ok := genericResourcesScope(collection); ok {
+		// the generic `resources` collection contains every Resource within the Subscription / Resource Group
+		prefix = resourceKey(scope) + "/"
+		for k := range s.resources {
+			if strings.HasPrefix(k, prefix) && strings.Contains(k, "/providers/") {
+				keys = append(keys, k)
+			}
+		}
+	} else {
+		for k := range s.resources {
+			if strings.HasPrefix(k, prefix) && !strings.Contains(strings.TrimPrefix(k, prefix), "/") {
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+
+	values := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, s.resources[k])
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"value": values,
+	})
+}
+
+func (s *Server) servePut(w http.ResponseWriter, r *http.Request, id string) {
+	body := make(map[string]interface{})
+	if b, err := ioutil.ReadAll(r.Body); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	} else if len(b) > 0 {
+		if err := json.Unmarshal(b, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+			return
+		}
+	}
+
+	if resourceGroupId := resourceGroupID(id); resourceGroupId != "" && resourceGroupId != canonicalID(id) {
+		if _, ok := s.resources[resourceKey(resourceGroupId)]; !ok {
+			writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group %q could not be found.", resourceGroupId))
+			return
+		}
+	}
+
+	key := resourceKey(id)
+	existing, exists := s.resources[key]
+
+	if r.Method == http.MethodPatch {
+		if !exists {
+			writeNotFound(w, id)
+			return
+		}
+		body = mergeResource(copyResource(existing), body)
+	}
+
+	resource := normalizeResource(id, body)
+	if compute, ok := s.computed[strings.ToLower(resourceType(id))]; ok {
+		compute(resource)
+	}
+	s.resources[key] = resource
+
+	if sideEffect, ok := s.sideEffects[strings.ToLower(resourceType(id))]; ok {
+		sideEffect(copyResource(resource), func(id string, resource map[string]interface{}) {
+			s.resources[resourceKey(id)] = normalizeResource(id, copyResource(resource))
+		})
+	}
+
+	statusCode := http.StatusOK
+	if !exists {
+		statusCode = http.StatusCreated
+	}
+
+	if longRunningStatusCode, ok := s.longRunning[longRunningKey(resourceType(id), r.Method)]; ok {
+		s.startOperation(w)
+
+		if longRunningStatusCode == http.StatusAccepted {
+			w.WriteHeader(longRunningStatusCode)
+			return
+		}
+
+		// the operation is still in progress, so the Resource returned needs to reflect that
+		response := copyResource(resource)
+		provisioningState := "Updating"
+		if !exists {
+			provisioningState = "Creating"
+		}
+		response["properties"].(map[string]interface{})["provisioningState"] = provisioningState
+
+		writeJSON(w, longRunningStatusCode, response)
+		return
+	}
+
+	writeJSON(w, statusCode, resource)
+}
+
+func (s *Server) serveDelete(w http.ResponseWriter, id string) {
+	key := resourceKey(id)
+	if _, ok := s.resources[key]; !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	s.deleteResource(key)
+
+	if statusCode, ok := s.longRunning[longRunningKey(resourceType(id), http.MethodDelete)]; ok {
+		s.startOperation(w)
+		w.WriteHeader(statusCode)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// deleteResource deletes the Resource with the specified key, along with any nested Resources (e.g. the
+// Resources within a Resource Group)
+func (s *Server) deleteResource(key string) {
+	for k := range s.resources {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(s.resources, k)
+		}
+	}
+}
+
+func (s *Server) serveAction(w http.ResponseWriter, r *http.Request, path string) {
+	i := strings.LastIndex(path, "/")
+	id, action := path[:i], path[i+1:]
+
+	if handler, ok := s.requests[actionKey(resourceType(id), action)]; ok {
+		s.serveRequestAction(w, r, id, handler)
+		return
+	}
+
+	resource, ok := s.resources[resourceKey(id)]
+	if !ok {
+		writeNotFound(w, id)
+		return
+	}
+
+	handler, ok := s.actions[actionKey(resourceType(id), action)]
+	if !ok {
+		writeError(w, http.StatusNotImplemented, "NotImplemented", fmt.Sprintf("The action %q isn't implemented for %q", action, resourceType(id)))
+		return
+	}
+
+	statusCode, body := handler(copyResource(resource))
+	writeJSON(w, statusCode, body)
+}
+
+func (s *Server) serveRequestAction(w http.ResponseWriter, r *http.Request, id string, handler RequestActionFunc) {
+	body := make(map[string]interface{})
+	if b, err := ioutil.ReadAll(r.Body); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+		return
+	} else if len(b) > 0 {
+		if err := json.Unmarshal(b, &body); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", err.Error())
+			return
+		}
+	}
+
+	if resourceGroupId := resourceGroupID(id); resourceGroupId != "" {
+		if _, ok := s.resources[resourceKey(resourceGroupId)]; !ok {
+			writeNotFound(w, resourceGroupId)
+			return
+		}
+	}
+
+	statusCode, response := handler(canonicalID(id), body)
+	writeJSON(w, statusCode, response)
+}
+
+// startOperation starts a new long running operation, returning its polling URL in the response headers
+func (s *Server) startOperation(w http.ResponseWriter) {
+	s.operationId++
+	operationId := fmt.Sprintf("%d", s.operationId)
+	s.operations[operationId] = s.PollsBeforeCompletion
+
+	w.Header().Set("Azure-AsyncOperation", s.URL+operationsPath+operationId)
+	w.Header().Set("Retry-After", "0")
+}
+
+func (s *Server) serveOperation(w http.ResponseWriter, operationId string) {
+	remaining, ok := s.operations[operationId]
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("The operation %q was not found", operationId))
+		return
+	}
+
+	status := "Succeeded"
+	if remaining > 0 {
+		status = "InProgress"
+		s.operations[operationId] = remaining - 1
+	}
+
+	w.Header().Set("Retry-After", "0")
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"status": status,
+	})
+}
+
+func listStorageAccountKeys(resource map[string]interface{}) (int, interface{}) {
+	return http.StatusOK, map[string]interface{}{
+		"keys": []interface{}{
+			map[string]interface{}{
+				"keyName":     "key1",
+				"permissions": "Full",
+				"value":       "ZmFrZWFybS1wcmltYXJ5LWtleQ==",
+			},
+			map[string]interface{}{
+				"keyName":     "key2",
+				"permissions": "Full",
+				"value":       "ZmFrZWFybS1zZWNvbmRhcnkta2V5",
+			},
+		},
+	}
+}
+
+// validateDeployment accepts any Template, since the fake API doesn't evaluate Templates
+func validateDeployment(id string, body map[string]interface{}) (int, interface{}) {
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	properties["provisioningState"] = "Succeeded"
+	properties["dependencies"] = []interface{}{}
+
+	return http.StatusOK, map[string]interface{}{
+		"id":         id,
+		"name":       id[strings.LastIndex(id, "/")+1:],
+		"properties": properties,
+	}
+}
+
+func computeStorageAccount(resource map[string]interface{}) {
+	// the API returns the Tier of the SKU (e.g. `Standard`), which is the prefix of its Name (e.g. `Standard_LRS`)
+	if sku, ok := resource["sku"].(map[string]interface{}); ok {
+		if name, ok := sku["name"].(string); ok {
+			sku["tier"] = strings.Split(name, "_")[0]
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	b, err := json.Marshal(body)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "InternalServerError", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	w.Write(b)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	b, _ := json.Marshal(map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	w.Write(b)
+}
+
+func writeNotFound(w http.ResponseWriter, id string) {
+	if resourceGroupID(id) == canonicalID(id) {
+		writeError(w, http.StatusNotFound, "ResourceGroupNotFound", fmt.Sprintf("Resource group %q could not be found.", id))
+		return
+	}
+
+	writeError(w, http.StatusNotFound, "ResourceNotFound", fmt.Sprintf("The Resource %q was not found.", id))
+}
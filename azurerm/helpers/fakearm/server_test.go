@@ -0,0 +1,308 @@
+package fakearm
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestResourceType(t *testing.T) {
+	cases := []struct {
+		ID       string
+		Expected string
+	}{
+		{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Expected: "Microsoft.Resources/subscriptions",
+		},
+		{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example",
+			Expected: "Microsoft.Resources/resourceGroups",
+		},
+		{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example",
+			Expected: "Microsoft.Network/virtualNetworks",
+		},
+		{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/subnets/internal",
+			Expected: "Microsoft.Network/virtualNetworks/subnets",
+		},
+		{
+			ID:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/dnsZones/example.com/A/www",
+			Expected: "Microsoft.Network/dnszones/A",
+		},
+	}
+
+	for _, v := range cases {
+		actual := resourceType(v.ID)
+		if actual != v.Expected {
+			t.Fatalf("Expected the Resource Type for %q to be %q but got %q", v.ID, v.Expected, actual)
+		}
+	}
+}
+
+func TestIsCollection(t *testing.T) {
+	cases := []struct {
+		Path     string
+		Expected bool
+	}{
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups",
+			Expected: true,
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example",
+			Expected: false,
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks",
+			Expected: true,
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example",
+			Expected: false,
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/subnets",
+			Expected: true,
+		},
+	}
+
+	for _, v := range cases {
+		actual := isCollection(v.Path)
+		if actual != v.Expected {
+			t.Fatalf("Expected isCollection for %q to be %t but got %t", v.Path, v.Expected, actual)
+		}
+	}
+}
+
+func TestGenericResourcesScope(t *testing.T) {
+	cases := []struct {
+		Path          string
+		ExpectedScope string
+		ExpectedOk    bool
+	}{
+		{
+			Path:          "/subscriptions/00000000-0000-0000-0000-000000000000/resources",
+			ExpectedScope: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			ExpectedOk:    true,
+		},
+		{
+			Path:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example/resources",
+			ExpectedScope: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			ExpectedOk:    true,
+		},
+		{
+			Path:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups",
+			ExpectedOk: false,
+		},
+		{
+			Path:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks",
+			ExpectedOk: false,
+		},
+	}
+
+	for _, v := range cases {
+		scope, ok := genericResourcesScope(v.Path)
+		if ok != v.ExpectedOk || scope != v.ExpectedScope {
+			t.Fatalf("Expected the scope for %q to be %q (%t) but got %q (%t)", v.Path, v.ExpectedScope, v.ExpectedOk, scope, ok)
+		}
+	}
+}
+
+func TestServer(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	resourceGroupId := "/subscriptions/" + SubscriptionID + "/resourceGroups/example"
+	virtualNetworkId := resourceGroupId + "/providers/Microsoft.Network/virtualNetworks/example"
+
+	// creating a Resource within a Resource Group which doesn't exist should fail
+	resp := testRequest(t, server, http.MethodPut, virtualNetworkId, `{"location":"westeurope"}`)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a 404 when the Resource Group doesn't exist but got %d", resp.StatusCode)
+	}
+
+	// the Azure SDK requests Resource Groups using a lower-case `resourcegroups`
+	resp = testRequest(t, server, http.MethodPut, "/subscriptions/"+SubscriptionID+"/resourcegroups/example", `{"location":"westeurope"}`)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected a 201 when creating the Resource Group but got %d", resp.StatusCode)
+	}
+
+	resource, ok := server.Get(resourceGroupId)
+	if !ok {
+		t.Fatalf("Expected the Resource Group to exist")
+	}
+	if resource["id"] != resourceGroupId {
+		t.Fatalf("Expected the ID to be %q but got %q", resourceGroupId, resource["id"])
+	}
+
+	// a long running operation returns the polling URL
+	resp = testRequest(t, server, http.MethodPut, virtualNetworkId, `{"location":"westeurope","properties":{"addressSpace":{"addressPrefixes":["10.0.0.0/16"]}}}`)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected a 201 when creating the Virtual Network but got %d", resp.StatusCode)
+	}
+	if body := testResponseBody(t, resp); body["properties"].(map[string]interface{})["provisioningState"] != "Creating" {
+		t.Fatalf("Expected the Virtual Network to be `Creating` but got %+v", body)
+	}
+
+	operationUri := resp.Header.Get("Azure-AsyncOperation")
+	if operationUri == "" {
+		t.Fatalf("Expected an `Azure-AsyncOperation` header but didn't get one")
+	}
+
+	for _, expected := range []string{"InProgress", "Succeeded"} {
+		resp = testRequest(t, server, http.MethodGet, strings.TrimPrefix(operationUri, server.URL), "")
+		if status := testResponseBody(t, resp)["status"]; status != expected {
+			t.Fatalf("Expected the operation status to be %q but got %q", expected, status)
+		}
+	}
+
+	// PATCH merges into the existing Resource
+	resp = testRequest(t, server, http.MethodPatch, virtualNetworkId, `{"tags":{"environment":"production"}}`)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a 200 when updating the Virtual Network but got %d", resp.StatusCode)
+	}
+	body := testResponseBody(t, resp)
+	if body["location"] != "westeurope" || body["tags"].(map[string]interface{})["environment"] != "production" {
+		t.Fatalf("Expected the PATCH to be merged into the existing Virtual Network but got %+v", body)
+	}
+
+	// listing the collection returns the Resource
+	resp = testRequest(t, server, http.MethodGet, resourceGroupId+"/providers/Microsoft.Network/virtualNetworks", "")
+	if values := testResponseBody(t, resp)["value"].([]interface{}); len(values) != 1 {
+		t.Fatalf("Expected 1 Virtual Network to be listed but got %d", len(values))
+	}
+
+	// as does listing the Resources within the Resource Group
+	resp = testRequest(t, server, http.MethodGet, resourceGroupId+"/resources", "")
+	if values := testResponseBody(t, resp)["value"].([]interface{}); len(values) != 1 {
+		t.Fatalf("Expected 1 Resource to be listed in the Resource Group but got %d", len(values))
+	}
+
+	// deleting the Resource Group deletes the Resources within it
+	resp = testRequest(t, server, http.MethodDelete, resourceGroupId, "")
+	if resp.StatusCode != http.StatusAccepted {
+		t.Fatalf("Expected a 202 when deleting the Resource Group but got %d", resp.StatusCode)
+	}
+	if ids := server.ResourceIDs(); len(ids) > 0 {
+		t.Fatalf("Expected no Resources to exist but got %+v", ids)
+	}
+
+	resp = testRequest(t, server, http.MethodGet, virtualNetworkId, "")
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a 404 when retrieving the deleted Virtual Network but got %d", resp.StatusCode)
+	}
+}
+
+func TestServerAction(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	resourceGroupId := "/subscriptions/" + SubscriptionID + "/resourceGroups/example"
+	storageAccountId := resourceGroupId + "/providers/Microsoft.Storage/storageAccounts/example"
+
+	server.Put(resourceGroupId, map[string]interface{}{"location": "westeurope"})
+	server.Put(storageAccountId, map[string]interface{}{"location": "westeurope"})
+
+	resp := testRequest(t, server, http.MethodPost, storageAccountId+"/listKeys", "")
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a 200 when listing the keys but got %d", resp.StatusCode)
+	}
+	if keys := testResponseBody(t, resp)["keys"].([]interface{}); len(keys) != 2 {
+		t.Fatalf("Expected 2 keys but got %d", len(keys))
+	}
+
+	resp = testRequest(t, server, http.MethodPost, storageAccountId+"/regenerateKey", "")
+	if resp.StatusCode != http.StatusNotImplemented {
+		t.Fatalf("Expected a 501 for an unregistered action but got %d", resp.StatusCode)
+	}
+}
+
+func TestServerSideEffect(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	resourceGroupId := "/subscriptions/" + SubscriptionID + "/resourceGroups/example"
+	deploymentId := resourceGroupId + "/providers/Microsoft.Resources/deployments/example"
+	storageAccountId := resourceGroupId + "/providers/Microsoft.Storage/storageAccounts/example"
+
+	server.SideEffect("Microsoft.Resources/deployments", func(deployment map[string]interface{}, put func(string, map[string]interface{})) {
+		put(storageAccountId, map[string]interface{}{"location": "westeurope"})
+	})
+	server.Put(resourceGroupId, map[string]interface{}{"location": "westeurope"})
+
+	resp := testRequest(t, server, http.MethodPut, deploymentId, `{"properties":{"mode":"Incremental"}}`)
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("Expected a 201 when creating the Deployment but got %d", resp.StatusCode)
+	}
+	if _, ok := server.Get(storageAccountId); !ok {
+		t.Fatalf("Expected the Storage Account to be created by the Deployment")
+	}
+
+	server.Delete(resourceGroupId)
+	if ids := server.ResourceIDs(); len(ids) > 0 {
+		t.Fatalf("Expected deleting the Resource Group to delete the Resources within it but got %+v", ids)
+	}
+}
+
+func TestServerRequestAction(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+
+	resourceGroupId := "/subscriptions/" + SubscriptionID + "/resourceGroups/example"
+	deploymentId := resourceGroupId + "/providers/Microsoft.Resources/deployments/example"
+	body := `{"properties":{"mode":"Incremental","template":{"resources":[]}}}`
+
+	resp := testRequest(t, server, http.MethodPost, deploymentId+"/validate", body)
+	if resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected a 404 when the Resource Group doesn't exist but got %d", resp.StatusCode)
+	}
+
+	server.Put(resourceGroupId, map[string]interface{}{"location": "westeurope"})
+
+	// the Deployment doesn't need to exist to be validated
+	resp = testRequest(t, server, http.MethodPost, deploymentId+"/validate", body)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected a 200 when validating the Deployment but got %d", resp.StatusCode)
+	}
+	properties := testResponseBody(t, resp)["properties"].(map[string]interface{})
+	if properties["mode"] != "Incremental" {
+		t.Fatalf("Expected the Deployment Properties to be returned but got %+v", properties)
+	}
+	if _, ok := server.Get(deploymentId); ok {
+		t.Fatalf("Expected the Deployment not to be created when it's validated")
+	}
+}
+
+func testRequest(t *testing.T, server *Server, method, path, body string) *http.Response {
+	req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+
+	return resp
+}
+
+func testResponseBody(t *testing.T, resp *http.Response) map[string]interface{} {
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Error reading response body: %+v", err)
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(b, &body); err != nil {
+		t.Fatalf("Error parsing response body %q: %+v", string(b), err)
+	}
+
+	return body
+}
@@ -22,9 +22,31 @@ type userItem struct {
 }
 
 type user struct {
-	ClientCertificteData string `yaml:"client-certificate-data"`
-	Token                string `yaml:"token"`
-	ClientKeyData        string `yaml:"client-key-data"`
+	ClientCertificteData string        `yaml:"client-certificate-data"`
+	Token                string        `yaml:"token"`
+	ClientKeyData        string        `yaml:"client-key-data"`
+	AuthProvider         *authProvider `yaml:"auth-provider,omitempty"`
+	Exec                 *exec         `yaml:"exec,omitempty"`
+}
+
+// authProvider is used by users which authenticate using a plugin built into kubectl (e.g. `azure` for clusters
+// integrated with Azure Active Directory)
+type authProvider struct {
+	Name   string            `yaml:"name"`
+	Config map[string]string `yaml:"config,omitempty"`
+}
+
+// exec is used by users which authenticate using credentials returned by an external command
+type exec struct {
+	APIVersion string        `yaml:"apiVersion"`
+	Command    string        `yaml:"command"`
+	Args       []string      `yaml:"args,omitempty"`
+	Env        []execEnvItem `yaml:"env,omitempty"`
+}
+
+type execEnvItem struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
 }
 
 type contextItem struct {
@@ -62,8 +84,8 @@ func ParseKubeConfig(config string) (*KubeConfig, error) {
 		return nil, fmt.Errorf("Config %+v contains no valid clusters or users", kubeConfig)
 	}
 	user := kubeConfig.Users[0].User
-	if user.Token == "" && (user.ClientCertificteData == "" || user.ClientKeyData == "") {
-		return nil, fmt.Errorf("Config requires either token or certificate auth for user %+v", user)
+	if !user.hasAuthentication() {
+		return nil, fmt.Errorf("Config requires either token, certificate, auth-provider or exec auth for user %+v", user)
 	}
 	cluster := kubeConfig.Clusters[0].Cluster
 	if cluster.Server == "" {
@@ -72,3 +94,19 @@ func ParseKubeConfig(config string) (*KubeConfig, error) {
 
 	return &kubeConfig, nil
 }
+
+func (u user) hasAuthentication() bool {
+	if u.Token != "" {
+		return true
+	}
+
+	if u.ClientCertificteData != "" && u.ClientKeyData != "" {
+		return true
+	}
+
+	if u.AuthProvider != nil && u.AuthProvider.Name != "" {
+		return true
+	}
+
+	return u.Exec != nil && u.Exec.Command != ""
+}
@@ -117,6 +117,100 @@ func TestParseKubeConfig(t *testing.T) {
 			},
 			isValidConfig,
 		},
+		{
+			"user_with_auth_provider.yml",
+			KubeConfig{
+				APIVersion: "v1",
+				Clusters: []clusterItem{
+					{
+						Name: "test-cluster",
+						Cluster: cluster{
+							ClusterAuthorityData: "test-cluster-authority-data",
+							Server:               "https://testcluster.org:443",
+						},
+					},
+				},
+				Users: []userItem{
+					{
+						Name: "clusterUser_test-group_test-cluster",
+						User: user{
+							AuthProvider: &authProvider{
+								Name: "azure",
+								Config: map[string]string{
+									"apiserver-id": "00000000-0000-0000-0000-000000000001",
+									"client-id":    "00000000-0000-0000-0000-000000000002",
+									"environment":  "AzurePublicCloud",
+									"tenant-id":    "00000000-0000-0000-0000-000000000003",
+								},
+							},
+						},
+					},
+				},
+				Contexts: []contextItem{
+					{
+						Name: "test-cluster",
+						Context: context{
+							Cluster: "test-cluster",
+							User:    "clusterUser_test-group_test-cluster",
+						},
+					},
+				},
+				CurrentContext: "test-cluster",
+				Kind:           "Config",
+				Preferences:    map[string]interface{}{},
+			},
+			isValidConfig,
+		},
+		{
+			"user_with_exec.yml",
+			KubeConfig{
+				APIVersion: "v1",
+				Clusters: []clusterItem{
+					{
+						Name: "test-cluster",
+						Cluster: cluster{
+							ClusterAuthorityData: "test-cluster-authority-data",
+							Server:               "https://testcluster.org:443",
+						},
+					},
+				},
+				Users: []userItem{
+					{
+						Name: "test-user",
+						User: user{
+							Exec: &exec{
+								APIVersion: "client.authentication.k8s.io/v1beta1",
+								Command:    "kubelogin",
+								Args:       []string{"get-token", "--server-id", "00000000-0000-0000-0000-000000000001"},
+								Env: []execEnvItem{
+									{
+										Name:  "AAD_LOGIN_METHOD",
+										Value: "spn",
+									},
+								},
+							},
+						},
+					},
+				},
+				Contexts: []contextItem{
+					{
+						Name: "test-cluster",
+						Context: context{
+							Cluster: "test-cluster",
+							User:    "test-user",
+						},
+					},
+				},
+				CurrentContext: "test-cluster",
+				Kind:           "Config",
+			},
+			isValidConfig,
+		},
+		{
+			"user_with_empty_exec.yml",
+			KubeConfig{},
+			isInvalidConfig,
+		},
 		{
 			"user_with_no_auth.yml",
 			KubeConfig{},
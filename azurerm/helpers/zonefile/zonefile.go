@@ -0,0 +1,522 @@
+package zonefile
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RecordSet is the set of Records within a Zone File which share the same Name and Type
+type RecordSet struct {
+	// Name is the name of the Record Set relative to the Zone, where `@` is the apex of the Zone
+	Name string
+
+	// Type is the (upper-case) Type of the Records, e.g. `MX`
+	Type string
+
+	TTL int64
+
+	// Records contains the fields of each Record (e.g. the Preference and Exchange of an MX Record), where
+	// domain names are fully qualified without a trailing dot and quoted strings have been unquoted
+	Records [][]string
+}
+
+// Parse parses an RFC 1035 Zone File for the specified Zone (e.g. `example.com`), returning the Record Sets
+// within it sorted by Name and Type. The `$ORIGIN` and `$TTL` directives are supported, as are the A, AAAA,
+// CAA, CNAME, MX, NS, PTR, SOA, SRV and TXT Record Types.
+func Parse(content, zone string) ([]RecordSet, error) {
+	entries, err := tokenize(content)
+	if err != nil {
+		return nil, err
+	}
+
+	zone = strings.ToLower(strings.TrimSuffix(zone, ".")) + "."
+	p := parser{
+		zone:   zone,
+		origin: zone,
+		sets:   make(map[string]*RecordSet),
+	}
+
+	for _, e := range entries {
+		if err := p.parseEntry(e); err != nil {
+			return nil, fmt.Errorf("line %d: %s", e.line, err)
+		}
+	}
+
+	recordSets := make([]RecordSet, 0, len(p.sets))
+	for _, v := range p.sets {
+		recordSets = append(recordSets, *v)
+	}
+	sort.Slice(recordSets, func(i, j int) bool {
+		if recordSets[i].Name != recordSets[j].Name {
+			return recordSets[i].Name < recordSets[j].Name
+		}
+		return recordSets[i].Type < recordSets[j].Type
+	})
+
+	return recordSets, nil
+}
+
+type token struct {
+	value  string
+	quoted bool
+}
+
+// entry is a logical line within the Zone File, which can span multiple lines when using parentheses
+type entry struct {
+	line int
+
+	// blankOwner is whether the entry begins with whitespace, in which case the owner of the previous Record is used
+	blankOwner bool
+
+	tokens []token
+}
+
+func tokenize(content string) ([]entry, error) {
+	entries := make([]entry, 0)
+	var current *entry
+	line := 1
+	depth := 0
+
+	start := func() {
+		if current == nil {
+			current = &entry{line: line}
+		}
+	}
+	flush := func() {
+		if current != nil && len(current.tokens) > 0 {
+			entries = append(entries, *current)
+		}
+		current = nil
+	}
+
+	for i := 0; i < len(content); {
+		c := content[i]
+		switch {
+		case c == '\n':
+			if depth == 0 {
+				flush()
+			}
+			line++
+			i++
+
+		case c == ';':
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+
+		case c == ' ' || c == '\t' || c == '\r':
+			if current == nil {
+				current = &entry{line: line, blankOwner: true}
+			}
+			i++
+
+		case c == '(':
+			start()
+			depth++
+			i++
+
+		case c == ')':
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unexpected `)`", line)
+			}
+			depth--
+			i++
+
+		case c == '"':
+			start()
+			value, n, err := readQuoted(content[i+1:])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", line, err)
+			}
+			current.tokens = append(current.tokens, token{value: value, quoted: true})
+			line += strings.Count(content[i:i+1+n], "\n")
+			i += 1 + n
+
+		default:
+			start()
+			var value bytes.Buffer
+			for i < len(content) && !strings.ContainsRune(" \t\r\n;()\"", rune(content[i])) {
+				if content[i] == '\\' && i+1 < len(content) {
+					i++
+				}
+				value.WriteByte(content[i])
+				i++
+			}
+			current.tokens = append(current.tokens, token{value: value.String()})
+		}
+	}
+
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: missing `)`", line)
+	}
+	flush()
+
+	return entries, nil
+}
+
+// readQuoted reads a quoted string up to (and including) the closing quote, returning the unescaped value
+// and the number of bytes which were read
+func readQuoted(input string) (string, int, error) {
+	var value bytes.Buffer
+	for i := 0; i < len(input); i++ {
+		switch input[i] {
+		case '"':
+			return value.String(), i + 1, nil
+
+		case '\\':
+			if i+1 >= len(input) {
+				break
+			}
+			i++
+
+			// `\DDD` is the character with the decimal value DDD
+			if i+2 < len(input) && isDigits(input[i:i+3]) {
+				v, _ := strconv.Atoi(input[i : i+3])
+				if v > 255 {
+					return "", 0, fmt.Errorf("invalid escape sequence `\\%s`", input[i:i+3])
+				}
+				value.WriteByte(byte(v))
+				i += 2
+				continue
+			}
+
+			value.WriteByte(input[i])
+
+		default:
+			value.WriteByte(input[i])
+		}
+	}
+
+	return "", 0, fmt.Errorf("missing closing quote")
+}
+
+type parser struct {
+	zone   string
+	origin string
+
+	defaultTTL *int64
+	lastTTL    *int64
+	lastOwner  string
+
+	sets map[string]*RecordSet
+}
+
+func (p *parser) parseEntry(e entry) error {
+	tokens := e.tokens
+
+	if !e.blankOwner && !tokens[0].quoted && strings.HasPrefix(tokens[0].value, "$") {
+		return p.parseDirective(tokens)
+	}
+
+	owner := p.lastOwner
+	if !e.blankOwner {
+		owner = strings.ToLower(p.absoluteName(tokens[0].value))
+		tokens = tokens[1:]
+	}
+	if owner == "" {
+		return fmt.Errorf("no owner name has been specified")
+	}
+	p.lastOwner = owner
+
+	// the TTL and Class are both optional and can be specified in either order
+	var ttl *int64
+	hasClass := false
+	for len(tokens) > 0 {
+		value := tokens[0].value
+		if !hasClass && isClass(value) {
+			if !strings.EqualFold(value, "IN") {
+				return fmt.Errorf("the class %q is not supported - only `IN` is supported", value)
+			}
+			hasClass = true
+			tokens = tokens[1:]
+			continue
+		}
+
+		if ttl == nil && len(value) > 0 && isDigits(value[:1]) {
+			v, err := parseTTL(value)
+			if err != nil {
+				return err
+			}
+			ttl = &v
+			tokens = tokens[1:]
+			continue
+		}
+
+		break
+	}
+
+	if len(tokens) == 0 {
+		return fmt.Errorf("the record type for %q has not been specified", owner)
+	}
+
+	if ttl != nil {
+		p.lastTTL = ttl
+	} else if p.defaultTTL != nil {
+		ttl = p.defaultTTL
+	} else if p.lastTTL != nil {
+		ttl = p.lastTTL
+	} else {
+		return fmt.Errorf("no TTL has been specified for %q and no `$TTL` directive precedes it", owner)
+	}
+
+	name, err := p.relativeName(owner)
+	if err != nil {
+		return err
+	}
+
+	recordType := strings.ToUpper(tokens[0].value)
+	fields, err := p.parseRecordData(recordType, tokens[1:])
+	if err != nil {
+		return fmt.Errorf("invalid %s record %q: %s", recordType, name, err)
+	}
+
+	key := name + "/" + recordType
+	recordSet, ok := p.sets[key]
+	if !ok {
+		recordSet = &RecordSet{
+			Name: name,
+			Type: recordType,
+			TTL:  *ttl,
+		}
+		p.sets[key] = recordSet
+	}
+
+	if recordSet.TTL != *ttl {
+		return fmt.Errorf("the TTL of the %s record %q (%d) doesn't match the TTL of the other records in the record set (%d)", recordType, name, *ttl, recordSet.TTL)
+	}
+
+	for _, existing := range recordSet.Records {
+		if strings.Join(existing, " ") == strings.Join(fields, " ") {
+			// duplicate records within a record set are ignored
+			return nil
+		}
+	}
+
+	if len(recordSet.Records) > 0 && (recordType == "CNAME" || recordType == "SOA") {
+		return fmt.Errorf("only a single %s record can be specified for %q", recordType, name)
+	}
+
+	recordSet.Records = append(recordSet.Records, fields)
+	return nil
+}
+
+func (p *parser) parseDirective(tokens []token) error {
+	directive := strings.ToUpper(tokens[0].value)
+	switch directive {
+	case "$ORIGIN":
+		if len(tokens) != 2 {
+			return fmt.Errorf("`$ORIGIN` expects a single domain name")
+		}
+		p.origin = strings.ToLower(p.absoluteName(tokens[1].value))
+
+	case "$TTL":
+		if len(tokens) != 2 {
+			return fmt.Errorf("`$TTL` expects a single TTL")
+		}
+		ttl, err := parseTTL(tokens[1].value)
+		if err != nil {
+			return err
+		}
+		p.defaultTTL = &ttl
+
+	default:
+		return fmt.Errorf("the directive %q is not supported", tokens[0].value)
+	}
+
+	return nil
+}
+
+func (p *parser) parseRecordData(recordType string, data []token) ([]string, error) {
+	expected := map[string]int{
+		"A":     1,
+		"AAAA":  1,
+		"CAA":   3,
+		"CNAME": 1,
+		"MX":    2,
+		"NS":    1,
+		"PTR":   1,
+		"SOA":   7,
+		"SRV":   4,
+	}
+
+	if recordType == "TXT" {
+		if len(data) == 0 {
+			return nil, fmt.Errorf("expected at least one string")
+		}
+	} else if count, ok := expected[recordType]; !ok {
+		return nil, fmt.Errorf("the record type is not supported")
+	} else if len(data) != count {
+		return nil, fmt.Errorf("expected %d fields but got %d", count, len(data))
+	}
+
+	fields := make([]string, len(data))
+	for i, v := range data {
+		fields[i] = v.value
+	}
+
+	switch recordType {
+	case "A":
+		ip := net.ParseIP(fields[0])
+		if ip == nil || ip.To4() == nil {
+			return nil, fmt.Errorf("%q is not a valid IPv4 address", fields[0])
+		}
+		fields[0] = ip.String()
+
+	case "AAAA":
+		ip := net.ParseIP(fields[0])
+		if ip == nil || ip.To4() != nil {
+			return nil, fmt.Errorf("%q is not a valid IPv6 address", fields[0])
+		}
+		fields[0] = ip.String()
+
+	case "CAA":
+		if err := validateInteger(fields[0], "flags", 255); err != nil {
+			return nil, err
+		}
+
+	case "CNAME", "NS", "PTR":
+		fields[0] = p.domainName(fields[0])
+
+	case "MX":
+		if err := validateInteger(fields[0], "preference", 65535); err != nil {
+			return nil, err
+		}
+		fields[1] = p.domainName(fields[1])
+
+	case "SOA":
+		fields[0] = p.domainName(fields[0])
+		fields[1] = p.domainName(fields[1])
+
+	case "SRV":
+		for i, name := range []string{"priority", "weight", "port"} {
+			if err := validateInteger(fields[i], name, 65535); err != nil {
+				return nil, err
+			}
+		}
+		fields[3] = p.domainName(fields[3])
+	}
+
+	return fields, nil
+}
+
+// absoluteName returns the fully qualified version of the specified name (including the trailing dot)
+func (p *parser) absoluteName(name string) string {
+	if name == "@" {
+		return p.origin
+	}
+
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "." + p.origin
+}
+
+// relativeName returns the name relative to the Zone, where `@` is the apex of the Zone
+func (p *parser) relativeName(name string) (string, error) {
+	if name == p.zone {
+		return "@", nil
+	}
+
+	if !strings.HasSuffix(name, "."+p.zone) {
+		return "", fmt.Errorf("%q is outside of the zone %q", strings.TrimSuffix(name, "."), strings.TrimSuffix(p.zone, "."))
+	}
+
+	return strings.TrimSuffix(name, "."+p.zone), nil
+}
+
+// domainName returns the fully qualified version of a domain name within the data of a Record, without the trailing dot
+func (p *parser) domainName(name string) string {
+	return strings.TrimSuffix(p.absoluteName(name), ".")
+}
+
+// parseTTL parses a TTL in seconds, which can also be specified using units (e.g. `1h30m`) as supported by BIND
+func parseTTL(input string) (int64, error) {
+	if isDigits(input) {
+		v, err := strconv.ParseInt(input, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		return checkTTL(input, v)
+	}
+
+	units := map[byte]int64{
+		's': 1,
+		'm': 60,
+		'h': 60 * 60,
+		'd': 24 * 60 * 60,
+		'w': 7 * 24 * 60 * 60,
+	}
+
+	total := int64(0)
+	number := ""
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if c >= '0' && c <= '9' {
+			number += string(c)
+			continue
+		}
+
+		multiplier, ok := units[c|0x20]
+		if !ok || number == "" {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+
+		v, err := strconv.ParseInt(number, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		total += v * multiplier
+		number = ""
+	}
+
+	if number != "" {
+		return 0, fmt.Errorf("%q is not a valid TTL", input)
+	}
+
+	return checkTTL(input, total)
+}
+
+func checkTTL(input string, ttl int64) (int64, error) {
+	if ttl < 0 || ttl > 2147483647 {
+		return 0, fmt.Errorf("%q is not a valid TTL", input)
+	}
+
+	return ttl, nil
+}
+
+func validateInteger(input, name string, max int) error {
+	v, err := strconv.Atoi(input)
+	if err != nil || !isDigits(input) || v > max {
+		return fmt.Errorf("the %s must be an integer between 0 and %d but got %q", name, max, input)
+	}
+
+	return nil
+}
+
+func isClass(input string) bool {
+	switch strings.ToUpper(input) {
+	case "IN", "CH", "CS", "HS":
+		return true
+	}
+
+	return false
+}
+
+func isDigits(input string) bool {
+	if input == "" {
+		return false
+	}
+
+	for _, c := range input {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
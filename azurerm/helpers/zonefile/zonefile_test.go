@@ -0,0 +1,169 @@
+package zonefile
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	content := `
+$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1 hostmaster (
+		2019010101 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		3600 )     ; minimum
+
+@		NS	ns1.example.net.
+		NS	ns2.example.net.
+@	300	IN	MX	10 mail
+	300	IN	MX	20 backup.example.net.
+@	IN	CAA	0 issue "letsencrypt.org"
+@	IN	TXT	"v=spf1 mx ~all"
+www	IN	A	10.0.0.1
+	IN	A	10.0.0.2
+WWW	IN	A	10.0.0.1 ; duplicates are ignored
+ipv6	IN	AAAA	2001:0db8:0000:0000:0000:0000:0000:0001
+ftp	IN 600	CNAME	www
+long	IN	TXT	( "first part "
+			  "second \"part\"" )
+_sip._tcp	1d	SRV	10 60 5060 sip.example.com.
+
+$ORIGIN sub.example.com.
+host	IN	A	192.168.0.1
+`
+
+	expected := []RecordSet{
+		{Name: "@", Type: "CAA", TTL: 3600, Records: [][]string{{"0", "issue", "letsencrypt.org"}}},
+		{Name: "@", Type: "MX", TTL: 300, Records: [][]string{{"10", "mail.example.com"}, {"20", "backup.example.net"}}},
+		{Name: "@", Type: "NS", TTL: 3600, Records: [][]string{{"ns1.example.net"}, {"ns2.example.net"}}},
+		{Name: "@", Type: "SOA", TTL: 3600, Records: [][]string{{"ns1.example.com", "hostmaster.example.com", "2019010101", "7200", "3600", "1209600", "3600"}}},
+		{Name: "@", Type: "TXT", TTL: 3600, Records: [][]string{{"v=spf1 mx ~all"}}},
+		{Name: "_sip._tcp", Type: "SRV", TTL: 86400, Records: [][]string{{"10", "60", "5060", "sip.example.com"}}},
+		{Name: "ftp", Type: "CNAME", TTL: 600, Records: [][]string{{"www.example.com"}}},
+		{Name: "host.sub", Type: "A", TTL: 3600, Records: [][]string{{"192.168.0.1"}}},
+		{Name: "ipv6", Type: "AAAA", TTL: 3600, Records: [][]string{{"2001:db8::1"}}},
+		{Name: "long", Type: "TXT", TTL: 3600, Records: [][]string{{"first part ", `second "part"`}}},
+		{Name: "www", Type: "A", TTL: 3600, Records: [][]string{{"10.0.0.1"}, {"10.0.0.2"}}},
+	}
+
+	actual, err := Parse(content, "example.com")
+	if err != nil {
+		t.Fatalf("Error parsing the Zone File: %+v", err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected the Record Sets:\n\n%+v\n\nbut got:\n\n%+v", expected, actual)
+	}
+}
+
+func TestParseTTLWithoutDirective(t *testing.T) {
+	content := `
+example.com.	300	IN	A	10.0.0.1
+www			IN	A	10.0.0.2
+`
+
+	actual, err := Parse(content, "example.com.")
+	if err != nil {
+		t.Fatalf("Error parsing the Zone File: %+v", err)
+	}
+
+	for _, v := range actual {
+		if v.TTL != 300 {
+			t.Fatalf("Expected the TTL of %q to be 300 but got %d", v.Name, v.TTL)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Content  string
+		Expected string
+	}{
+		{
+			Name:     "No TTL",
+			Content:  "www IN A 10.0.0.1",
+			Expected: "line 1: no TTL has been specified",
+		},
+		{
+			Name:     "Outside of the Zone",
+			Content:  "$TTL 300\nwww.example.org. IN A 10.0.0.1",
+			Expected: `line 2: "www.example.org" is outside of the zone "example.com"`,
+		},
+		{
+			Name:     "Mismatched TTL",
+			Content:  "www 300 IN A 10.0.0.1\nwww 600 IN A 10.0.0.2",
+			Expected: "line 2: the TTL of the A record \"www\" (600) doesn't match",
+		},
+		{
+			Name:     "Invalid IPv4 Address",
+			Content:  "$TTL 300\nwww IN A 2001:db8::1",
+			Expected: `line 2: invalid A record "www": "2001:db8::1" is not a valid IPv4 address`,
+		},
+		{
+			Name:     "Invalid MX Preference",
+			Content:  "$TTL 300\n@ IN MX high mail",
+			Expected: `the preference must be an integer between 0 and 65535 but got "high"`,
+		},
+		{
+			Name:     "Wrong Number of Fields",
+			Content:  "$TTL 300\n_sip._tcp IN SRV 10 60 sip",
+			Expected: "expected 4 fields but got 3",
+		},
+		{
+			Name:     "Multiple CNAME Records",
+			Content:  "$TTL 300\nftp IN CNAME www\nftp IN CNAME mail",
+			Expected: `line 3: only a single CNAME record can be specified for "ftp"`,
+		},
+		{
+			Name:     "Unsupported Record Type",
+			Content:  "$TTL 300\n@ IN SSHFP 1 1 abcdef",
+			Expected: "the record type is not supported",
+		},
+		{
+			Name:     "Unsupported Class",
+			Content:  "$TTL 300\n@ CH A 10.0.0.1",
+			Expected: `the class "CH" is not supported`,
+		},
+		{
+			Name:     "Unsupported Directive",
+			Content:  "$INCLUDE other.zone",
+			Expected: `the directive "$INCLUDE" is not supported`,
+		},
+		{
+			Name:     "Invalid TTL",
+			Content:  "$TTL 1x",
+			Expected: `"1x" is not a valid TTL`,
+		},
+		{
+			Name:     "Missing Closing Parenthesis",
+			Content:  "$TTL 300\n@ IN TXT ( \"hello\"",
+			Expected: "missing `)`",
+		},
+		{
+			Name:     "Missing Closing Quote",
+			Content:  "$TTL 300\n@ IN TXT \"hello",
+			Expected: "line 2: missing closing quote",
+		},
+		{
+			Name:     "No Owner",
+			Content:  "$TTL 300\n  IN A 10.0.0.1",
+			Expected: "line 2: no owner name has been specified",
+		},
+	}
+
+	for _, v := range cases {
+		_, err := Parse(v.Content, "example.com")
+		if err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+		}
+
+		if !strings.Contains(err.Error(), v.Expected) {
+			t.Fatalf("Expected the error for %q to contain %q but got %q", v.Name, v.Expected, err.Error())
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package azurerm
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// armResourceTypesToTerraform maps the (lower-cased) Resource Manager Types returned from the `resources`
+// API to the Terraform Resource which manages them. Types which map to different Terraform Resources depending
+// on the `kind` of the Resource are handled in `terraformResourceTypeForArmResource`.
+var armResourceTypesToTerraform = map[string]string{
+	"microsoft.automation/automationaccounts":                "azurerm_automation_account",
+	"microsoft.automation/automationaccounts/runbooks":       "azurerm_automation_runbook",
+	"microsoft.cache/redis":                                  "azurerm_redis_cache",
+	"microsoft.cdn/profiles":                                 "azurerm_cdn_profile",
+	"microsoft.cdn/profiles/endpoints":                       "azurerm_cdn_endpoint",
+	"microsoft.compute/availabilitysets":                     "azurerm_availability_set",
+	"microsoft.compute/disks":                                "azurerm_managed_disk",
+	"microsoft.compute/images":                               "azurerm_image",
+	"microsoft.compute/snapshots":                            "azurerm_snapshot",
+	"microsoft.compute/virtualmachines":                      "azurerm_virtual_machine",
+	"microsoft.compute/virtualmachines/extensions":           "azurerm_virtual_machine_extension",
+	"microsoft.compute/virtualmachinescalesets":              "azurerm_virtual_machine_scale_set",
+	"microsoft.containerinstance/containergroups":            "azurerm_container_group",
+	"microsoft.containerregistry/registries":                 "azurerm_container_registry",
+	"microsoft.containerservice/managedclusters":             "azurerm_kubernetes_cluster",
+	"microsoft.datalakeanalytics/accounts":                   "azurerm_data_lake_analytics_account",
+	"microsoft.datalakestore/accounts":                       "azurerm_data_lake_store",
+	"microsoft.dbformysql/servers":                           "azurerm_mysql_server",
+	"microsoft.dbforpostgresql/servers":                      "azurerm_postgresql_server",
+	"microsoft.devices/iothubs":                              "azurerm_iothub",
+	"microsoft.devtestlab/labs":                              "azurerm_dev_test_lab",
+	"microsoft.devtestlab/labs/virtualnetworks":              "azurerm_dev_test_virtual_network",
+	"microsoft.documentdb/databaseaccounts":                  "azurerm_cosmosdb_account",
+	"microsoft.eventgrid/topics":                             "azurerm_eventgrid_topic",
+	"microsoft.eventhub/namespaces":                          "azurerm_eventhub_namespace",
+	"microsoft.insights/actiongroups":                        "azurerm_monitor_action_group",
+	"microsoft.insights/alertrules":                          "azurerm_metric_alertrule",
+	"microsoft.insights/autoscalesettings":                   "azurerm_autoscale_setting",
+	"microsoft.insights/components":                          "azurerm_application_insights",
+	"microsoft.keyvault/vaults":                              "azurerm_key_vault",
+	"microsoft.logic/workflows":                              "azurerm_logic_app_workflow",
+	"microsoft.managedidentity/userassignedidentities":       "azurerm_user_assigned_identity",
+	"microsoft.network/applicationgateways":                  "azurerm_application_gateway",
+	"microsoft.network/applicationsecuritygroups":            "azurerm_application_security_group",
+	"microsoft.network/azurefirewalls":                       "azurerm_firewall",
+	"microsoft.network/connections":                          "azurerm_virtual_network_gateway_connection",
+	"microsoft.network/dnszones":                             "azurerm_dns_zone",
+	"microsoft.network/expressroutecircuits":                 "azurerm_express_route_circuit",
+	"microsoft.network/loadbalancers":                        "azurerm_lb",
+	"microsoft.network/localnetworkgateways":                 "azurerm_local_network_gateway",
+	"microsoft.network/networkinterfaces":                    "azurerm_network_interface",
+	"microsoft.network/networksecuritygroups":                "azurerm_network_security_group",
+	"microsoft.network/networkwatchers":                      "azurerm_network_watcher",
+	"microsoft.network/publicipaddresses":                    "azurerm_public_ip",
+	"microsoft.network/routetables":                          "azurerm_route_table",
+	"microsoft.network/trafficmanagerprofiles":               "azurerm_traffic_manager_profile",
+	"microsoft.network/virtualnetworkgateways":               "azurerm_virtual_network_gateway",
+	"microsoft.network/virtualnetworks":                      "azurerm_virtual_network",
+	"microsoft.notificationhubs/namespaces":                  "azurerm_notification_hub_namespace",
+	"microsoft.notificationhubs/namespaces/notificationhubs": "azurerm_notification_hub",
+	"microsoft.operationalinsights/workspaces":               "azurerm_log_analytics_workspace",
+	"microsoft.operationsmanagement/solutions":               "azurerm_log_analytics_solution",
+	"microsoft.recoveryservices/vaults":                      "azurerm_recovery_services_vault",
+	"microsoft.relay/namespaces":                             "azurerm_relay_namespace",
+	"microsoft.scheduler/jobcollections":                     "azurerm_scheduler_job_collection",
+	"microsoft.search/searchservices":                        "azurerm_search_service",
+	"microsoft.servicebus/namespaces":                        "azurerm_servicebus_namespace",
+	"microsoft.servicefabric/clusters":                       "azurerm_service_fabric_cluster",
+	"microsoft.sql/servers":                                  "azurerm_sql_server",
+	"microsoft.sql/servers/databases":                        "azurerm_sql_database",
+	"microsoft.sql/servers/elasticpools":                     "azurerm_sql_elasticpool",
+	"microsoft.storage/storageaccounts":                      "azurerm_storage_account",
+	"microsoft.web/serverfarms":                              "azurerm_app_service_plan",
+	"microsoft.web/sites":                                    "azurerm_app_service",
+	"microsoft.web/sites/slots":                              "azurerm_app_service_slot",
+}
+
+// discoveredResource is a Resource found in Azure, along with the Terraform Resource it can be imported into
+type discoveredResource struct {
+	ID                    string
+	Name                  string
+	Type                  string
+	Location              string
+	ResourceGroup         string
+	TerraformResourceType string
+	TerraformResourceName string
+}
+
+func flattenDiscoveredResource(input resources.GenericResource) discoveredResource {
+	output := discoveredResource{}
+	if input.ID != nil {
+		output.ID = *input.ID
+		if id, err := parseAzureResourceID(output.ID); err == nil {
+			output.ResourceGroup = id.ResourceGroup
+		}
+	}
+	if input.Name != nil {
+		output.Name = *input.Name
+	}
+	if input.Type != nil {
+		output.Type = *input.Type
+	}
+	if input.Location != nil {
+		output.Location = azureRMNormalizeLocation(*input.Location)
+	}
+
+	kind := ""
+	if input.Kind != nil {
+		kind = *input.Kind
+	}
+	output.TerraformResourceType = terraformResourceTypeForArmResource(output.Type, kind)
+
+	return output
+}
+
+func flattenDiscoveredResourceGroup(input resources.Group) discoveredResource {
+	output := discoveredResource{
+		Type:                  "Microsoft.Resources/resourceGroups",
+		TerraformResourceType: "azurerm_resource_group",
+	}
+	if input.ID != nil {
+		output.ID = *input.ID
+	}
+	if input.Name != nil {
+		output.Name = *input.Name
+	}
+	if input.Location != nil {
+		output.Location = azureRMNormalizeLocation(*input.Location)
+	}
+
+	return output
+}
+
+// terraformResourceTypeForArmResource returns the Terraform Resource which can be used to import the specified
+// Resource Manager Type (and Kind) - or an empty string if there isn't one
+func terraformResourceTypeForArmResource(armType string, kind string) string {
+	armType = strings.ToLower(armType)
+
+	// Function Apps are App Services with a different `kind`
+	if armType == "microsoft.web/sites" && strings.Contains(strings.ToLower(kind), "functionapp") {
+		return "azurerm_function_app"
+	}
+
+	return armResourceTypesToTerraform[armType]
+}
+
+var terraformResourceNameInvalidChars = regexp.MustCompile("[^a-z0-9_]+")
+
+// terraformResourceName returns a unique name which can be used for the Resource within the Terraform
+// Configuration, based on the name of the Resource in Azure (e.g. `example-vnet` becomes `example_vnet`)
+func terraformResourceName(name string, used map[string]struct{}) string {
+	output := terraformResourceNameInvalidChars.ReplaceAllString(strings.ToLower(name), "_")
+	output = strings.Trim(output, "_")
+	if output == "" || (output[0] >= '0' && output[0] <= '9') {
+		output = "resource_" + output
+	}
+	output = strings.TrimSuffix(output, "_")
+
+	candidate := output
+	for i := 2; ; i++ {
+		if _, exists := used[candidate]; !exists {
+			break
+		}
+		candidate = fmt.Sprintf("%s_%d", output, i)
+	}
+
+	used[candidate] = struct{}{}
+	return candidate
+}
+
+// importDiscoveryCommands returns the `terraform import` commands for each of the discovered Resources
+func importDiscoveryCommands(resources []discoveredResource) string {
+	var buffer bytes.Buffer
+	for _, r := range resources {
+		buffer.WriteString(fmt.Sprintf("terraform import %s.%s %q\n", r.TerraformResourceType, r.TerraformResourceName, r.ID))
+	}
+	return buffer.String()
+}
+
+// importDiscoveryConfiguration returns a skeleton Terraform Configuration for each of the discovered Resources,
+// containing the arguments which identify each Resource. The remaining arguments can be completed using the
+// diff shown in `terraform plan` once the Resources have been imported.
+func importDiscoveryConfiguration(resources []discoveredResource, resourcesMap map[string]*schema.Resource) string {
+	var buffer bytes.Buffer
+	for i, r := range resources {
+		if i > 0 {
+			buffer.WriteString("\n")
+		}
+
+		s := resourcesMap[r.TerraformResourceType].Schema
+		buffer.WriteString(fmt.Sprintf("resource %q %q {\n", r.TerraformResourceType, r.TerraformResourceName))
+		if _, ok := s["name"]; ok {
+			// the names of child resources (e.g. SQL Databases) are returned in the format `parent/child`
+			name := r.Name[strings.LastIndex(r.Name, "/")+1:]
+			buffer.WriteString(fmt.Sprintf("  name = %q\n", name))
+		}
+		if _, ok := s["resource_group_name"]; ok && r.ResourceGroup != "" {
+			buffer.WriteString(fmt.Sprintf("  resource_group_name = %q\n", r.ResourceGroup))
+		}
+		if _, ok := s["location"]; ok && r.Location != "" {
+			buffer.WriteString(fmt.Sprintf("  location = %q\n", r.Location))
+		}
+		buffer.WriteString("}\n")
+	}
+	return buffer.String()
+}
+
+// sortDiscoveredResources sorts the Resources by ID, such that Resource Groups are listed before the Resources within them
+func sortDiscoveredResources(resources []discoveredResource) {
+	sort.Slice(resources, func(i, j int) bool {
+		return strings.ToLower(resources[i].ID) < strings.ToLower(resources[j].ID)
+	})
+}
@@ -0,0 +1,105 @@
+package azurerm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestArmResourceTypesToTerraform(t *testing.T) {
+	resourcesMap := Provider().(*schema.Provider).ResourcesMap
+
+	for armType, resourceType := range armResourceTypesToTerraform {
+		if armType != strings.ToLower(armType) {
+			t.Fatalf("Expected the Resource Manager Type %q to be lower-cased", armType)
+		}
+
+		resource, ok := resourcesMap[resourceType]
+		if !ok {
+			t.Fatalf("Expected %q (mapped from %q) to be a Resource", resourceType, armType)
+		}
+		if resource.Importer == nil {
+			t.Fatalf("Expected %q (mapped from %q) to support being imported", resourceType, armType)
+		}
+	}
+}
+
+func TestTerraformResourceTypeForArmResource(t *testing.T) {
+	cases := []struct {
+		Type     string
+		Kind     string
+		Expected string
+	}{
+		{
+			Type:     "Microsoft.Network/virtualNetworks",
+			Expected: "azurerm_virtual_network",
+		},
+		{
+			Type:     "Microsoft.Sql/servers/databases",
+			Expected: "azurerm_sql_database",
+		},
+		{
+			Type:     "Microsoft.Web/sites",
+			Kind:     "app",
+			Expected: "azurerm_app_service",
+		},
+		{
+			Type:     "Microsoft.Web/sites",
+			Kind:     "functionapp,linux",
+			Expected: "azurerm_function_app",
+		},
+		{
+			Type:     "Microsoft.Web/certificates",
+			Expected: "",
+		},
+	}
+
+	for _, v := range cases {
+		actual := terraformResourceTypeForArmResource(v.Type, v.Kind)
+		if actual != v.Expected {
+			t.Fatalf("Expected the Terraform Resource for %q (Kind %q) to be %q but got %q", v.Type, v.Kind, v.Expected, actual)
+		}
+	}
+}
+
+func TestTerraformResourceName(t *testing.T) {
+	used := make(map[string]struct{})
+
+	cases := []struct {
+		Name     string
+		Expected string
+	}{
+		{
+			Name:     "example",
+			Expected: "example",
+		},
+		{
+			Name:     "Example-VNet.01",
+			Expected: "example_vnet_01",
+		},
+		{
+			Name:     "example-vnet-01",
+			Expected: "example_vnet_01_2",
+		},
+		{
+			Name:     "sqlserver/master",
+			Expected: "sqlserver_master",
+		},
+		{
+			Name:     "1-storage",
+			Expected: "resource_1_storage",
+		},
+		{
+			Name:     "---",
+			Expected: "resource",
+		},
+	}
+
+	for _, v := range cases {
+		actual := terraformResourceName(v.Name, used)
+		if actual != v.Expected {
+			t.Fatalf("Expected the Terraform Resource Name for %q to be %q but got %q", v.Name, v.Expected, actual)
+		}
+	}
+}
@@ -9,11 +9,14 @@ import (
 	"log"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
 	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
@@ -42,6 +45,19 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_SECRET", ""),
 			},
 
+			"client_certificate_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PATH", ""),
+			},
+
+			"client_certificate_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_CLIENT_CERTIFICATE_PASSWORD", ""),
+			},
+
 			"tenant_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -65,6 +81,78 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"resource_provider_registrations": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_RESOURCE_PROVIDER_REGISTRATIONS", resourceProviderRegistrationsAll),
+				ValidateFunc: validation.StringInSlice([]string{
+					resourceProviderRegistrationsAll,
+					resourceProviderRegistrationsCore,
+					resourceProviderRegistrationsNone,
+				}, false),
+			},
+
+			"resource_providers_to_register": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", 5),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"max_retry_wait_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRY_WAIT_IN_SECONDS", 60),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: validateAzureRMTags,
+						},
+					},
+				},
+			},
+
+			"ignore_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"key_prefixes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+					},
+				},
+			},
+
 			"use_msi": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -79,7 +167,9 @@ func Provider() terraform.ResourceProvider {
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"azurerm_azuread_application":                   dataSourceArmAzureADApplication(),
+			"azurerm_azuread_group":                         dataSourceArmActiveDirectoryGroup(),
 			"azurerm_azuread_service_principal":             dataSourceArmActiveDirectoryServicePrincipal(),
+			"azurerm_azuread_user":                          dataSourceArmActiveDirectoryUser(),
 			"azurerm_application_security_group":            dataSourceArmApplicationSecurityGroup(),
 			"azurerm_app_service":                           dataSourceArmAppService(),
 			"azurerm_app_service_plan":                      dataSourceAppServicePlan(),
@@ -93,6 +183,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_dns_zone":                              dataSourceArmDnsZone(),
 			"azurerm_eventhub_namespace":                    dataSourceEventHubNamespace(),
 			"azurerm_image":                                 dataSourceArmImage(),
+			"azurerm_import_discovery":                      dataSourceArmImportDiscovery(),
 			"azurerm_key_vault":                             dataSourceArmKeyVault(),
 			"azurerm_key_vault_access_policy":               dataSourceArmKeyVaultAccessPolicy(),
 			"azurerm_key_vault_secret":                      dataSourceArmKeyVaultSecret(),
@@ -119,163 +210,193 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_subnet":                                dataSourceArmSubnet(),
 			"azurerm_subscription":                          dataSourceArmSubscription(),
 			"azurerm_subscriptions":                         dataSourceArmSubscriptions(),
+			"azurerm_template_deployment":                   dataSourceArmTemplateDeployment(),
 			"azurerm_traffic_manager_geographical_location": dataSourceArmTrafficManagerGeographicalLocation(),
 			"azurerm_virtual_network":                       dataSourceArmVirtualNetwork(),
 			"azurerm_virtual_network_gateway":               dataSourceArmVirtualNetworkGateway(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"azurerm_azuread_application":                     resourceArmActiveDirectoryApplication(),
-			"azurerm_azuread_service_principal":               resourceArmActiveDirectoryServicePrincipal(),
-			"azurerm_azuread_service_principal_password":      resourceArmActiveDirectoryServicePrincipalPassword(),
-			"azurerm_application_gateway":                     resourceArmApplicationGateway(),
-			"azurerm_application_insights":                    resourceArmApplicationInsights(),
-			"azurerm_application_security_group":              resourceArmApplicationSecurityGroup(),
-			"azurerm_app_service":                             resourceArmAppService(),
-			"azurerm_app_service_plan":                        resourceArmAppServicePlan(),
-			"azurerm_app_service_active_slot":                 resourceArmAppServiceActiveSlot(),
-			"azurerm_app_service_custom_hostname_binding":     resourceArmAppServiceCustomHostnameBinding(),
-			"azurerm_app_service_slot":                        resourceArmAppServiceSlot(),
-			"azurerm_automation_account":                      resourceArmAutomationAccount(),
-			"azurerm_automation_credential":                   resourceArmAutomationCredential(),
-			"azurerm_automation_runbook":                      resourceArmAutomationRunbook(),
-			"azurerm_automation_schedule":                     resourceArmAutomationSchedule(),
-			"azurerm_autoscale_setting":                       resourceArmAutoScaleSetting(),
-			"azurerm_availability_set":                        resourceArmAvailabilitySet(),
-			"azurerm_cdn_endpoint":                            resourceArmCdnEndpoint(),
-			"azurerm_cdn_profile":                             resourceArmCdnProfile(),
-			"azurerm_container_registry":                      resourceArmContainerRegistry(),
-			"azurerm_container_service":                       resourceArmContainerService(),
-			"azurerm_container_group":                         resourceArmContainerGroup(),
-			"azurerm_cosmosdb_account":                        resourceArmCosmosDBAccount(),
-			"azurerm_data_lake_analytics_account":             resourceArmDataLakeAnalyticsAccount(),
-			"azurerm_data_lake_analytics_firewall_rule":       resourceArmDataLakeAnalyticsFirewallRule(),
-			"azurerm_data_lake_store":                         resourceArmDataLakeStore(),
-			"azurerm_data_lake_store_file":                    resourceArmDataLakeStoreFile(),
-			"azurerm_data_lake_store_firewall_rule":           resourceArmDataLakeStoreFirewallRule(),
-			"azurerm_dev_test_lab":                            resourceArmDevTestLab(),
-			"azurerm_dev_test_virtual_network":                resourceArmDevTestVirtualNetwork(),
-			"azurerm_dns_a_record":                            resourceArmDnsARecord(),
-			"azurerm_dns_aaaa_record":                         resourceArmDnsAAAARecord(),
-			"azurerm_dns_caa_record":                          resourceArmDnsCaaRecord(),
-			"azurerm_dns_cname_record":                        resourceArmDnsCNameRecord(),
-			"azurerm_dns_mx_record":                           resourceArmDnsMxRecord(),
-			"azurerm_dns_ns_record":                           resourceArmDnsNsRecord(),
-			"azurerm_dns_ptr_record":                          resourceArmDnsPtrRecord(),
-			"azurerm_dns_srv_record":                          resourceArmDnsSrvRecord(),
-			"azurerm_dns_txt_record":                          resourceArmDnsTxtRecord(),
-			"azurerm_dns_zone":                                resourceArmDnsZone(),
-			"azurerm_eventgrid_topic":                         resourceArmEventGridTopic(),
-			"azurerm_eventhub":                                resourceArmEventHub(),
-			"azurerm_eventhub_authorization_rule":             resourceArmEventHubAuthorizationRule(),
-			"azurerm_eventhub_consumer_group":                 resourceArmEventHubConsumerGroup(),
-			"azurerm_eventhub_namespace":                      resourceArmEventHubNamespace(),
-			"azurerm_eventhub_namespace_authorization_rule":   resourceArmEventHubNamespaceAuthorizationRule(),
-			"azurerm_express_route_circuit":                   resourceArmExpressRouteCircuit(),
-			"azurerm_express_route_circuit_authorization":     resourceArmExpressRouteCircuitAuthorization(),
-			"azurerm_express_route_circuit_peering":           resourceArmExpressRouteCircuitPeering(),
-			"azurerm_firewall":                                resourceArmFirewall(),
-			"azurerm_firewall_network_rule_collection":        resourceArmFirewallNetworkRuleCollection(),
-			"azurerm_function_app":                            resourceArmFunctionApp(),
-			"azurerm_image":                                   resourceArmImage(),
-			"azurerm_iothub":                                  resourceArmIotHub(),
-			"azurerm_key_vault":                               resourceArmKeyVault(),
-			"azurerm_key_vault_access_policy":                 resourceArmKeyVaultAccessPolicy(),
-			"azurerm_key_vault_certificate":                   resourceArmKeyVaultCertificate(),
-			"azurerm_key_vault_key":                           resourceArmKeyVaultKey(),
-			"azurerm_key_vault_secret":                        resourceArmKeyVaultSecret(),
-			"azurerm_kubernetes_cluster":                      resourceArmKubernetesCluster(),
-			"azurerm_lb":                                      resourceArmLoadBalancer(),
-			"azurerm_lb_backend_address_pool":                 resourceArmLoadBalancerBackendAddressPool(),
-			"azurerm_lb_nat_rule":                             resourceArmLoadBalancerNatRule(),
-			"azurerm_lb_nat_pool":                             resourceArmLoadBalancerNatPool(),
-			"azurerm_lb_probe":                                resourceArmLoadBalancerProbe(),
-			"azurerm_lb_rule":                                 resourceArmLoadBalancerRule(),
-			"azurerm_local_network_gateway":                   resourceArmLocalNetworkGateway(),
-			"azurerm_log_analytics_solution":                  resourceArmLogAnalyticsSolution(),
-			"azurerm_log_analytics_workspace":                 resourceArmLogAnalyticsWorkspace(),
-			"azurerm_logic_app_action_custom":                 resourceArmLogicAppActionCustom(),
-			"azurerm_logic_app_action_http":                   resourceArmLogicAppActionHTTP(),
-			"azurerm_logic_app_trigger_custom":                resourceArmLogicAppTriggerCustom(),
-			"azurerm_logic_app_trigger_http_request":          resourceArmLogicAppTriggerHttpRequest(),
-			"azurerm_logic_app_trigger_recurrence":            resourceArmLogicAppTriggerRecurrence(),
-			"azurerm_logic_app_workflow":                      resourceArmLogicAppWorkflow(),
-			"azurerm_managed_disk":                            resourceArmManagedDisk(),
-			"azurerm_management_lock":                         resourceArmManagementLock(),
-			"azurerm_management_group":                        resourceArmManagementGroup(),
-			"azurerm_metric_alertrule":                        resourceArmMetricAlertRule(),
-			"azurerm_monitor_action_group":                    resourceArmMonitorActionGroup(),
-			"azurerm_mysql_configuration":                     resourceArmMySQLConfiguration(),
-			"azurerm_mysql_database":                          resourceArmMySqlDatabase(),
-			"azurerm_mysql_firewall_rule":                     resourceArmMySqlFirewallRule(),
-			"azurerm_mysql_server":                            resourceArmMySqlServer(),
-			"azurerm_mysql_virtual_network_rule":              resourceArmMySqlVirtualNetworkRule(),
-			"azurerm_network_interface":                       resourceArmNetworkInterface(),
-			"azurerm_network_security_group":                  resourceArmNetworkSecurityGroup(),
-			"azurerm_network_security_rule":                   resourceArmNetworkSecurityRule(),
-			"azurerm_network_watcher":                         resourceArmNetworkWatcher(),
-			"azurerm_notification_hub":                        resourceArmNotificationHub(),
-			"azurerm_notification_hub_authorization_rule":     resourceArmNotificationHubAuthorizationRule(),
-			"azurerm_notification_hub_namespace":              resourceArmNotificationHubNamespace(),
-			"azurerm_packet_capture":                          resourceArmPacketCapture(),
-			"azurerm_policy_assignment":                       resourceArmPolicyAssignment(),
-			"azurerm_policy_definition":                       resourceArmPolicyDefinition(),
-			"azurerm_postgresql_configuration":                resourceArmPostgreSQLConfiguration(),
-			"azurerm_postgresql_database":                     resourceArmPostgreSQLDatabase(),
-			"azurerm_postgresql_firewall_rule":                resourceArmPostgreSQLFirewallRule(),
-			"azurerm_postgresql_server":                       resourceArmPostgreSQLServer(),
-			"azurerm_postgresql_virtual_network_rule":         resourceArmPostgreSQLVirtualNetworkRule(),
-			"azurerm_public_ip":                               resourceArmPublicIp(),
-			"azurerm_relay_namespace":                         resourceArmRelayNamespace(),
-			"azurerm_recovery_services_vault":                 resourceArmRecoveryServicesVault(),
-			"azurerm_redis_cache":                             resourceArmRedisCache(),
-			"azurerm_redis_firewall_rule":                     resourceArmRedisFirewallRule(),
-			"azurerm_resource_group":                          resourceArmResourceGroup(),
-			"azurerm_role_assignment":                         resourceArmRoleAssignment(),
-			"azurerm_role_definition":                         resourceArmRoleDefinition(),
-			"azurerm_route":                                   resourceArmRoute(),
-			"azurerm_route_table":                             resourceArmRouteTable(),
-			"azurerm_search_service":                          resourceArmSearchService(),
-			"azurerm_servicebus_namespace":                    resourceArmServiceBusNamespace(),
-			"azurerm_servicebus_namespace_authorization_rule": resourceArmServiceBusNamespaceAuthorizationRule(),
-			"azurerm_servicebus_queue":                        resourceArmServiceBusQueue(),
-			"azurerm_servicebus_queue_authorization_rule":     resourceArmServiceBusQueueAuthorizationRule(),
-			"azurerm_servicebus_subscription":                 resourceArmServiceBusSubscription(),
-			"azurerm_servicebus_subscription_rule":            resourceArmServiceBusSubscriptionRule(),
-			"azurerm_servicebus_topic":                        resourceArmServiceBusTopic(),
-			"azurerm_servicebus_topic_authorization_rule":     resourceArmServiceBusTopicAuthorizationRule(),
-			"azurerm_service_fabric_cluster":                  resourceArmServiceFabricCluster(),
-			"azurerm_snapshot":                                resourceArmSnapshot(),
-			"azurerm_scheduler_job":                           resourceArmSchedulerJob(),
-			"azurerm_scheduler_job_collection":                resourceArmSchedulerJobCollection(),
-			"azurerm_sql_database":                            resourceArmSqlDatabase(),
-			"azurerm_sql_elasticpool":                         resourceArmSqlElasticPool(),
-			"azurerm_sql_firewall_rule":                       resourceArmSqlFirewallRule(),
-			"azurerm_sql_active_directory_administrator":      resourceArmSqlAdministrator(),
-			"azurerm_sql_server":                              resourceArmSqlServer(),
-			"azurerm_sql_virtual_network_rule":                resourceArmSqlVirtualNetworkRule(),
-			"azurerm_storage_account":                         resourceArmStorageAccount(),
-			"azurerm_storage_blob":                            resourceArmStorageBlob(),
-			"azurerm_storage_container":                       resourceArmStorageContainer(),
-			"azurerm_storage_share":                           resourceArmStorageShare(),
-			"azurerm_storage_queue":                           resourceArmStorageQueue(),
-			"azurerm_storage_table":                           resourceArmStorageTable(),
-			"azurerm_subnet":                                  resourceArmSubnet(),
-			"azurerm_template_deployment":                     resourceArmTemplateDeployment(),
-			"azurerm_traffic_manager_endpoint":                resourceArmTrafficManagerEndpoint(),
-			"azurerm_traffic_manager_profile":                 resourceArmTrafficManagerProfile(),
-			"azurerm_user_assigned_identity":                  resourceArmUserAssignedIdentity(),
-			"azurerm_virtual_machine":                         resourceArmVirtualMachine(),
-			"azurerm_virtual_machine_data_disk_attachment":    resourceArmVirtualMachineDataDiskAttachment(),
-			"azurerm_virtual_machine_extension":               resourceArmVirtualMachineExtensions(),
-			"azurerm_virtual_machine_scale_set":               resourceArmVirtualMachineScaleSet(),
-			"azurerm_virtual_network":                         resourceArmVirtualNetwork(),
-			"azurerm_virtual_network_gateway":                 resourceArmVirtualNetworkGateway(),
-			"azurerm_virtual_network_gateway_connection":      resourceArmVirtualNetworkGatewayConnection(),
-			"azurerm_virtual_network_peering":                 resourceArmVirtualNetworkPeering(),
+			"azurerm_azuread_application":                                resourceArmActiveDirectoryApplication(),
+			"azurerm_azuread_group":                                      resourceArmActiveDirectoryGroup(),
+			"azurerm_azuread_group_member":                               resourceArmActiveDirectoryGroupMember(),
+			"azurerm_azuread_service_principal":                          resourceArmActiveDirectoryServicePrincipal(),
+			"azurerm_azuread_service_principal_certificate":              resourceArmActiveDirectoryServicePrincipalCertificate(),
+			"azurerm_azuread_service_principal_password":                 resourceArmActiveDirectoryServicePrincipalPassword(),
+			"azurerm_application_gateway":                                resourceArmApplicationGateway(),
+			"azurerm_application_insights":                               resourceArmApplicationInsights(),
+			"azurerm_application_security_group":                         resourceArmApplicationSecurityGroup(),
+			"azurerm_app_service":                                        resourceArmAppService(),
+			"azurerm_app_service_plan":                                   resourceArmAppServicePlan(),
+			"azurerm_app_service_active_slot":                            resourceArmAppServiceActiveSlot(),
+			"azurerm_app_service_custom_hostname_binding":                resourceArmAppServiceCustomHostnameBinding(),
+			"azurerm_app_service_slot":                                   resourceArmAppServiceSlot(),
+			"azurerm_automation_account":                                 resourceArmAutomationAccount(),
+			"azurerm_automation_credential":                              resourceArmAutomationCredential(),
+			"azurerm_automation_runbook":                                 resourceArmAutomationRunbook(),
+			"azurerm_automation_schedule":                                resourceArmAutomationSchedule(),
+			"azurerm_autoscale_setting":                                  resourceArmAutoScaleSetting(),
+			"azurerm_availability_set":                                   resourceArmAvailabilitySet(),
+			"azurerm_cdn_endpoint":                                       resourceArmCdnEndpoint(),
+			"azurerm_cdn_profile":                                        resourceArmCdnProfile(),
+			"azurerm_container_registry":                                 resourceArmContainerRegistry(),
+			"azurerm_container_service":                                  resourceArmContainerService(),
+			"azurerm_container_group":                                    resourceArmContainerGroup(),
+			"azurerm_cosmosdb_account":                                   resourceArmCosmosDBAccount(),
+			"azurerm_data_lake_analytics_account":                        resourceArmDataLakeAnalyticsAccount(),
+			"azurerm_data_lake_analytics_firewall_rule":                  resourceArmDataLakeAnalyticsFirewallRule(),
+			"azurerm_data_lake_store":                                    resourceArmDataLakeStore(),
+			"azurerm_data_lake_store_file":                               resourceArmDataLakeStoreFile(),
+			"azurerm_data_lake_store_firewall_rule":                      resourceArmDataLakeStoreFirewallRule(),
+			"azurerm_dev_test_lab":                                       resourceArmDevTestLab(),
+			"azurerm_dev_test_virtual_network":                           resourceArmDevTestVirtualNetwork(),
+			"azurerm_dns_a_record":                                       resourceArmDnsARecord(),
+			"azurerm_dns_aaaa_record":                                    resourceArmDnsAAAARecord(),
+			"azurerm_dns_caa_record":                                     resourceArmDnsCaaRecord(),
+			"azurerm_dns_cname_record":                                   resourceArmDnsCNameRecord(),
+			"azurerm_dns_mx_record":                                      resourceArmDnsMxRecord(),
+			"azurerm_dns_ns_record":                                      resourceArmDnsNsRecord(),
+			"azurerm_dns_ptr_record":                                     resourceArmDnsPtrRecord(),
+			"azurerm_dns_srv_record":                                     resourceArmDnsSrvRecord(),
+			"azurerm_dns_txt_record":                                     resourceArmDnsTxtRecord(),
+			"azurerm_dns_zone":                                           resourceArmDnsZone(),
+			"azurerm_dns_zone_file":                                      resourceArmDnsZoneFile(),
+			"azurerm_eventgrid_topic":                                    resourceArmEventGridTopic(),
+			"azurerm_eventhub":                                           resourceArmEventHub(),
+			"azurerm_eventhub_authorization_rule":                        resourceArmEventHubAuthorizationRule(),
+			"azurerm_eventhub_consumer_group":                            resourceArmEventHubConsumerGroup(),
+			"azurerm_eventhub_namespace":                                 resourceArmEventHubNamespace(),
+			"azurerm_eventhub_namespace_authorization_rule":              resourceArmEventHubNamespaceAuthorizationRule(),
+			"azurerm_express_route_circuit":                              resourceArmExpressRouteCircuit(),
+			"azurerm_express_route_circuit_authorization":                resourceArmExpressRouteCircuitAuthorization(),
+			"azurerm_express_route_circuit_peering":                      resourceArmExpressRouteCircuitPeering(),
+			"azurerm_firewall":                                           resourceArmFirewall(),
+			"azurerm_firewall_application_rule_collection":               resourceArmFirewallApplicationRuleCollection(),
+			"azurerm_firewall_nat_rule_collection":                       resourceArmFirewallNatRuleCollection(),
+			"azurerm_firewall_network_rule_collection":                   resourceArmFirewallNetworkRuleCollection(),
+			"azurerm_function_app":                                       resourceArmFunctionApp(),
+			"azurerm_image":                                              resourceArmImage(),
+			"azurerm_iothub":                                             resourceArmIotHub(),
+			"azurerm_key_vault":                                          resourceArmKeyVault(),
+			"azurerm_key_vault_access_policy":                            resourceArmKeyVaultAccessPolicy(),
+			"azurerm_key_vault_certificate":                              resourceArmKeyVaultCertificate(),
+			"azurerm_key_vault_key":                                      resourceArmKeyVaultKey(),
+			"azurerm_key_vault_secret":                                   resourceArmKeyVaultSecret(),
+			"azurerm_kubernetes_cluster":                                 resourceArmKubernetesCluster(),
+			"azurerm_kubernetes_cluster_node_pool":                       resourceArmKubernetesClusterNodePool(),
+			"azurerm_lb":                                                 resourceArmLoadBalancer(),
+			"azurerm_lb_backend_address_pool":                            resourceArmLoadBalancerBackendAddressPool(),
+			"azurerm_lb_nat_rule":                                        resourceArmLoadBalancerNatRule(),
+			"azurerm_lb_nat_pool":                                        resourceArmLoadBalancerNatPool(),
+			"azurerm_lb_probe":                                           resourceArmLoadBalancerProbe(),
+			"azurerm_lb_rule":                                            resourceArmLoadBalancerRule(),
+			"azurerm_local_network_gateway":                              resourceArmLocalNetworkGateway(),
+			"azurerm_log_analytics_solution":                             resourceArmLogAnalyticsSolution(),
+			"azurerm_log_analytics_workspace":                            resourceArmLogAnalyticsWorkspace(),
+			"azurerm_logic_app_action_custom":                            resourceArmLogicAppActionCustom(),
+			"azurerm_logic_app_action_http":                              resourceArmLogicAppActionHTTP(),
+			"azurerm_logic_app_trigger_custom":                           resourceArmLogicAppTriggerCustom(),
+			"azurerm_logic_app_trigger_http_request":                     resourceArmLogicAppTriggerHttpRequest(),
+			"azurerm_logic_app_trigger_recurrence":                       resourceArmLogicAppTriggerRecurrence(),
+			"azurerm_logic_app_workflow":                                 resourceArmLogicAppWorkflow(),
+			"azurerm_managed_disk":                                       resourceArmManagedDisk(),
+			"azurerm_management_lock":                                    resourceArmManagementLock(),
+			"azurerm_management_group":                                   resourceArmManagementGroup(),
+			"azurerm_management_group_template_deployment":               resourceArmManagementGroupTemplateDeployment(),
+			"azurerm_metric_alertrule":                                   resourceArmMetricAlertRule(),
+			"azurerm_monitor_action_group":                               resourceArmMonitorActionGroup(),
+			"azurerm_mysql_configuration":                                resourceArmMySQLConfiguration(),
+			"azurerm_mysql_database":                                     resourceArmMySqlDatabase(),
+			"azurerm_mysql_firewall_rule":                                resourceArmMySqlFirewallRule(),
+			"azurerm_mysql_server":                                       resourceArmMySqlServer(),
+			"azurerm_mysql_virtual_network_rule":                         resourceArmMySqlVirtualNetworkRule(),
+			"azurerm_network_interface":                                  resourceArmNetworkInterface(),
+			"azurerm_network_interface_backend_address_pool_association": resourceArmNetworkInterfaceBackendAddressPoolAssociation(),
+			"azurerm_network_security_group":                             resourceArmNetworkSecurityGroup(),
+			"azurerm_network_security_rule":                              resourceArmNetworkSecurityRule(),
+			"azurerm_network_watcher":                                    resourceArmNetworkWatcher(),
+			"azurerm_notification_hub":                                   resourceArmNotificationHub(),
+			"azurerm_notification_hub_authorization_rule":                resourceArmNotificationHubAuthorizationRule(),
+			"azurerm_notification_hub_namespace":                         resourceArmNotificationHubNamespace(),
+			"azurerm_packet_capture":                                     resourceArmPacketCapture(),
+			"azurerm_policy_assignment":                                  resourceArmPolicyAssignment(),
+			"azurerm_policy_definition":                                  resourceArmPolicyDefinition(),
+			"azurerm_postgresql_configuration":                           resourceArmPostgreSQLConfiguration(),
+			"azurerm_postgresql_database":                                resourceArmPostgreSQLDatabase(),
+			"azurerm_postgresql_firewall_rule":                           resourceArmPostgreSQLFirewallRule(),
+			"azurerm_postgresql_server":                                  resourceArmPostgreSQLServer(),
+			"azurerm_postgresql_virtual_network_rule":                    resourceArmPostgreSQLVirtualNetworkRule(),
+			"azurerm_private_dns_a_record":                               resourceArmPrivateDnsARecord(),
+			"azurerm_private_dns_aaaa_record":                            resourceArmPrivateDnsAAAARecord(),
+			"azurerm_private_dns_cname_record":                           resourceArmPrivateDnsCNameRecord(),
+			"azurerm_private_dns_mx_record":                              resourceArmPrivateDnsMxRecord(),
+			"azurerm_private_dns_ptr_record":                             resourceArmPrivateDnsPtrRecord(),
+			"azurerm_private_dns_srv_record":                             resourceArmPrivateDnsSrvRecord(),
+			"azurerm_private_dns_txt_record":                             resourceArmPrivateDnsTxtRecord(),
+			"azurerm_private_dns_zone":                                   resourceArmPrivateDnsZone(),
+			"azurerm_private_dns_zone_virtual_network_link":              resourceArmPrivateDnsZoneVirtualNetworkLink(),
+			"azurerm_public_ip":                                          resourceArmPublicIp(),
+			"azurerm_relay_namespace":                                    resourceArmRelayNamespace(),
+			"azurerm_recovery_services_vault":                            resourceArmRecoveryServicesVault(),
+			"azurerm_redis_cache":                                        resourceArmRedisCache(),
+			"azurerm_redis_firewall_rule":                                resourceArmRedisFirewallRule(),
+			"azurerm_resource_group":                                     resourceArmResourceGroup(),
+			"azurerm_role_assignment":                                    resourceArmRoleAssignment(),
+			"azurerm_role_definition":                                    resourceArmRoleDefinition(),
+			"azurerm_route":                                              resourceArmRoute(),
+			"azurerm_route_table":                                        resourceArmRouteTable(),
+			"azurerm_search_service":                                     resourceArmSearchService(),
+			"azurerm_servicebus_namespace":                               resourceArmServiceBusNamespace(),
+			"azurerm_servicebus_namespace_authorization_rule":            resourceArmServiceBusNamespaceAuthorizationRule(),
+			"azurerm_servicebus_queue":                                   resourceArmServiceBusQueue(),
+			"azurerm_servicebus_queue_authorization_rule":                resourceArmServiceBusQueueAuthorizationRule(),
+			"azurerm_servicebus_subscription":                            resourceArmServiceBusSubscription(),
+			"azurerm_servicebus_subscription_rule":                       resourceArmServiceBusSubscriptionRule(),
+			"azurerm_servicebus_topic":                                   resourceArmServiceBusTopic(),
+			"azurerm_servicebus_topic_authorization_rule":                resourceArmServiceBusTopicAuthorizationRule(),
+			"azurerm_service_fabric_cluster":                             resourceArmServiceFabricCluster(),
+			"azurerm_snapshot":                                           resourceArmSnapshot(),
+			"azurerm_scheduler_job":                                      resourceArmSchedulerJob(),
+			"azurerm_scheduler_job_collection":                           resourceArmSchedulerJobCollection(),
+			"azurerm_sql_database":                                       resourceArmSqlDatabase(),
+			"azurerm_sql_elasticpool":                                    resourceArmSqlElasticPool(),
+			"azurerm_sql_firewall_rule":                                  resourceArmSqlFirewallRule(),
+			"azurerm_sql_active_directory_administrator":                 resourceArmSqlAdministrator(),
+			"azurerm_sql_server":                                         resourceArmSqlServer(),
+			"azurerm_sql_virtual_network_rule":                           resourceArmSqlVirtualNetworkRule(),
+			"azurerm_storage_account":                                    resourceArmStorageAccount(),
+			"azurerm_storage_blob":                                       resourceArmStorageBlob(),
+			"azurerm_storage_container":                                  resourceArmStorageContainer(),
+			"azurerm_storage_share":                                      resourceArmStorageShare(),
+			"azurerm_storage_queue":                                      resourceArmStorageQueue(),
+			"azurerm_storage_table":                                      resourceArmStorageTable(),
+			"azurerm_subnet":                                             resourceArmSubnet(),
+			"azurerm_subnet_network_security_group_association":          resourceArmSubnetNetworkSecurityGroupAssociation(),
+			"azurerm_subnet_route_table_association":                     resourceArmSubnetRouteTableAssociation(),
+			"azurerm_subscription_template_deployment":                   resourceArmSubscriptionTemplateDeployment(),
+			"azurerm_template_deployment":                                resourceArmTemplateDeployment(),
+			"azurerm_traffic_manager_endpoint":                           resourceArmTrafficManagerEndpoint(),
+			"azurerm_traffic_manager_profile":                            resourceArmTrafficManagerProfile(),
+			"azurerm_user_assigned_identity":                             resourceArmUserAssignedIdentity(),
+			"azurerm_virtual_machine":                                    resourceArmVirtualMachine(),
+			"azurerm_virtual_machine_data_disk_attachment":               resourceArmVirtualMachineDataDiskAttachment(),
+			"azurerm_virtual_machine_extension":                          resourceArmVirtualMachineExtensions(),
+			"azurerm_virtual_machine_scale_set":                          resourceArmVirtualMachineScaleSet(),
+			"azurerm_virtual_network":                                    resourceArmVirtualNetwork(),
+			"azurerm_virtual_network_gateway":                            resourceArmVirtualNetworkGateway(),
+			"azurerm_virtual_network_gateway_connection":                 resourceArmVirtualNetworkGatewayConnection(),
+			"azurerm_virtual_network_peering":                            resourceArmVirtualNetworkPeering(),
 		},
 	}
 
+	for _, r := range p.ResourcesMap {
+		addTagsAllToResource(r)
+		addSubscriptionIdToResource(r, false)
+	}
+	for _, r := range p.DataSourcesMap {
+		addSubscriptionIdToResource(r, true)
+	}
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
@@ -287,12 +408,16 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			SubscriptionID:            d.Get("subscription_id").(string),
 			ClientID:                  d.Get("client_id").(string),
 			ClientSecret:              d.Get("client_secret").(string),
+			ClientCertPath:            d.Get("client_certificate_path").(string),
+			ClientCertPassword:        d.Get("client_certificate_password").(string),
 			TenantID:                  d.Get("tenant_id").(string),
 			Environment:               d.Get("environment").(string),
 			UseMsi:                    d.Get("use_msi").(bool),
 			MsiEndpoint:               d.Get("msi_endpoint").(string),
 			SkipCredentialsValidation: d.Get("skip_credentials_validation").(bool),
 			SkipProviderRegistration:  d.Get("skip_provider_registration").(bool),
+			MaxRetries:                d.Get("max_retries").(int),
+			MaxRetryWait:              time.Duration(d.Get("max_retry_wait_in_seconds").(int)) * time.Second,
 		}
 
 		if config.UseMsi {
@@ -309,6 +434,11 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			if err := config.ValidateMsi(); err != nil {
 				return nil, err
 			}
+		} else if config.ClientCertPath != "" {
+			log.Printf("[DEBUG] Client Certificate specified - using Service Principal (Client Certificate) for Authentication")
+			if err := config.ValidateServicePrincipalCertificate(); err != nil {
+				return nil, err
+			}
 		} else if config.ClientSecret != "" {
 			log.Printf("[DEBUG] Client Secret specified - using Service Principal for Authentication")
 			if err := config.ValidateServicePrincipal(); err != nil {
@@ -331,10 +461,13 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 		}
 
 		client.StopContext = p.StopContext()
+		client.defaultTags = expandProviderDefaultTags(d)
+		client.ignoreTags = expandProviderIgnoreTags(d)
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
 			client.StopContext = p.StopContext()
+			client.resetSubscriptionClients()
 			return nil
 		}
 
@@ -350,7 +483,14 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			}
 
 			if !config.SkipProviderRegistration {
-				err = registerAzureResourceProvidersWithSubscription(ctx, providerList.Values(), client.providersClient)
+				mode := d.Get("resource_provider_registrations").(string)
+				additional := make([]string, 0)
+				for _, v := range d.Get("resource_providers_to_register").([]interface{}) {
+					additional = append(additional, v.(string))
+				}
+
+				providers := resourceProvidersForRegistration(mode, additional)
+				err = registerAzureResourceProvidersWithSubscription(ctx, providerList.Values(), client.providersClient, providers)
 				if err != nil {
 					return nil, err
 				}
@@ -361,6 +501,93 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	}
 }
 
+const (
+	resourceProviderRegistrationsAll  = "all"
+	resourceProviderRegistrationsCore = "core"
+	resourceProviderRegistrationsNone = "none"
+)
+
+// coreResourceProviders are the Resource Providers required by the most commonly used resources,
+// which are registered when `resource_provider_registrations` is set to either `core` or `all`
+var coreResourceProviders = []string{
+	"Microsoft.Authorization",
+	"Microsoft.Compute",
+	"Microsoft.Network",
+	"Microsoft.Resources",
+	"Microsoft.Storage",
+}
+
+// allResourceProviders are all of the Resource Providers which the resources in this provider may
+// require, which are registered when `resource_provider_registrations` is set to `all`
+var allResourceProviders = []string{
+	"Microsoft.Authorization",
+	"Microsoft.Automation",
+	"Microsoft.Cache",
+	"Microsoft.Cdn",
+	"Microsoft.Compute",
+	"Microsoft.ContainerInstance",
+	"Microsoft.ContainerRegistry",
+	"Microsoft.ContainerService",
+	"Microsoft.DataLakeStore",
+	"Microsoft.DBforMySQL",
+	"Microsoft.DBforPostgreSQL",
+	"Microsoft.Devices",
+	"Microsoft.DevTestLab",
+	"Microsoft.DocumentDB",
+	"Microsoft.EventGrid",
+	"Microsoft.EventHub",
+	"Microsoft.KeyVault",
+	"microsoft.insights",
+	"Microsoft.Logic",
+	"Microsoft.ManagedIdentity",
+	"Microsoft.Management",
+	"Microsoft.Network",
+	"Microsoft.NotificationHubs",
+	"Microsoft.OperationalInsights",
+	"Microsoft.Relay",
+	"Microsoft.Resources",
+	"Microsoft.Search",
+	"Microsoft.ServiceBus",
+	"Microsoft.ServiceFabric",
+	"Microsoft.Sql",
+	"Microsoft.Storage",
+}
+
+// resourceProvidersForRegistration returns the Resource Providers which should be registered for the specified
+// mode and additional namespaces, mapped to whether a failure to register them is fatal. Failures registering
+// namespaces which are only included by the `all` mode are logged as a warning instead, since these may never be used.
+func resourceProvidersForRegistration(mode string, additional []string) map[string]bool {
+	providers := make(map[string]bool)
+	add := func(namespace string, required bool) {
+		// Resource Provider namespaces are case-insensitive
+		for k, v := range providers {
+			if strings.EqualFold(k, namespace) {
+				providers[k] = v || required
+				return
+			}
+		}
+		providers[namespace] = required
+	}
+
+	if mode == resourceProviderRegistrationsAll {
+		for _, v := range allResourceProviders {
+			add(v, false)
+		}
+	}
+
+	if mode == resourceProviderRegistrationsAll || mode == resourceProviderRegistrationsCore {
+		for _, v := range coreResourceProviders {
+			add(v, true)
+		}
+	}
+
+	for _, v := range additional {
+		add(v, true)
+	}
+
+	return providers
+}
+
 func registerProviderWithSubscription(ctx context.Context, providerName string, client resources.ProvidersClient) error {
 	_, err := client.Register(ctx, providerName)
 	if err != nil {
@@ -370,80 +597,64 @@ func registerProviderWithSubscription(ctx context.Context, providerName string,
 	return nil
 }
 
-func determineAzureResourceProvidersToRegister(providerList []resources.Provider) map[string]struct{} {
-	providers := map[string]struct{}{
-		"Microsoft.Authorization":       {},
-		"Microsoft.Automation":          {},
-		"Microsoft.Cache":               {},
-		"Microsoft.Cdn":                 {},
-		"Microsoft.Compute":             {},
-		"Microsoft.ContainerInstance":   {},
-		"Microsoft.ContainerRegistry":   {},
-		"Microsoft.ContainerService":    {},
-		"Microsoft.DataLakeStore":       {},
-		"Microsoft.DBforMySQL":          {},
-		"Microsoft.DBforPostgreSQL":     {},
-		"Microsoft.Devices":             {},
-		"Microsoft.DevTestLab":          {},
-		"Microsoft.DocumentDB":          {},
-		"Microsoft.EventGrid":           {},
-		"Microsoft.EventHub":            {},
-		"Microsoft.KeyVault":            {},
-		"microsoft.insights":            {},
-		"Microsoft.Logic":               {},
-		"Microsoft.ManagedIdentity":     {},
-		"Microsoft.Management":          {},
-		"Microsoft.Network":             {},
-		"Microsoft.NotificationHubs":    {},
-		"Microsoft.OperationalInsights": {},
-		"Microsoft.Relay":               {},
-		"Microsoft.Resources":           {},
-		"Microsoft.Search":              {},
-		"Microsoft.ServiceBus":          {},
-		"Microsoft.ServiceFabric":       {},
-		"Microsoft.Sql":                 {},
-		"Microsoft.Storage":             {},
+// determineAzureResourceProvidersToRegister filters out any of the specified Resource Providers which are already registered
+func determineAzureResourceProvidersToRegister(providerList []resources.Provider, providers map[string]bool) map[string]bool {
+	output := make(map[string]bool, len(providers))
+	for k, v := range providers {
+		output[k] = v
 	}
 
-	// filter out any providers already registered
 	for _, p := range providerList {
-		if _, ok := providers[*p.Namespace]; !ok {
+		if p.Namespace == nil || p.RegistrationState == nil {
 			continue
 		}
 
-		if strings.ToLower(*p.RegistrationState) == "registered" {
-			log.Printf("[DEBUG] Skipping provider registration for namespace %s\n", *p.Namespace)
-			delete(providers, *p.Namespace)
+		if !strings.EqualFold(*p.RegistrationState, "registered") {
+			continue
+		}
+
+		for namespace := range output {
+			if strings.EqualFold(namespace, *p.Namespace) {
+				log.Printf("[DEBUG] Skipping provider registration for namespace %s\n", *p.Namespace)
+				delete(output, namespace)
+			}
 		}
 	}
 
-	return providers
+	return output
 }
 
-// registerAzureResourceProvidersWithSubscription uses the providers client to register
-// all Azure resource providers which the Terraform provider may require (regardless of
-// whether they are actually used by the configuration or not). It was confirmed by Microsoft
-// that this is the approach their own internal tools also take.
-func registerAzureResourceProvidersWithSubscription(ctx context.Context, providerList []resources.Provider, client resources.ProvidersClient) error {
-	providers := determineAzureResourceProvidersToRegister(providerList)
+// registerAzureResourceProvidersWithSubscription uses the providers client to register the specified
+// Azure resource providers which aren't already registered. Failures registering a provider which isn't
+// required are logged as a warning, rather than returned.
+func registerAzureResourceProvidersWithSubscription(ctx context.Context, providerList []resources.Provider, client resources.ProvidersClient, providers map[string]bool) error {
+	providers = determineAzureResourceProvidersToRegister(providerList, providers)
 
-	var err error
+	var errs *multierror.Error
+	var mutex sync.Mutex
 	var wg sync.WaitGroup
 	wg.Add(len(providers))
 
-	for providerName := range providers {
-		go func(p string) {
+	for providerName, required := range providers {
+		go func(p string, required bool) {
 			defer wg.Done()
 			log.Printf("[DEBUG] Registering provider with namespace %s\n", p)
-			if innerErr := registerProviderWithSubscription(ctx, p, client); err != nil {
-				err = innerErr
+			if err := registerProviderWithSubscription(ctx, p, client); err != nil {
+				if !required {
+					log.Printf("[WARN] %s This namespace is only required by some resources, so this has been ignored.", err)
+					return
+				}
+
+				mutex.Lock()
+				errs = multierror.Append(errs, err)
+				mutex.Unlock()
 			}
-		}(providerName)
+		}(providerName, required)
 	}
 
 	wg.Wait()
 
-	return err
+	return errs.ErrorOrNil()
 }
 
 // armMutexKV is the instance of MutexKV for ARM resources
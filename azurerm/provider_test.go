@@ -1,16 +1,26 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"reflect"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
@@ -125,13 +135,239 @@ func TestAccAzureRMResourceProviderRegistration(t *testing.T) {
 			"error: %s", err)
 	}
 
-	err = registerAzureResourceProvidersWithSubscription(ctx, providerList.Values(), client)
+	providers := resourceProvidersForRegistration(resourceProviderRegistrationsAll, []string{})
+	err = registerAzureResourceProvidersWithSubscription(ctx, providerList.Values(), client, providers)
 	if err != nil {
 		t.Fatalf("Error registering Resource Providers: %+v", err)
 	}
 
-	needingRegistration := determineAzureResourceProvidersToRegister(providerList.Values())
+	needingRegistration := determineAzureResourceProvidersToRegister(providerList.Values(), providers)
 	if len(needingRegistration) > 0 {
 		t.Fatalf("'%d' Resource Providers are still Pending Registration: %s", len(needingRegistration), spew.Sprint(needingRegistration))
 	}
 }
+
+func TestResourceProvidersForRegistration(t *testing.T) {
+	cases := []struct {
+		Name       string
+		Mode       string
+		Additional []string
+		Expected   map[string]bool
+	}{
+		{
+			Name:       "None",
+			Mode:       resourceProviderRegistrationsNone,
+			Additional: []string{},
+			Expected:   map[string]bool{},
+		},
+		{
+			Name:       "None with Additional",
+			Mode:       resourceProviderRegistrationsNone,
+			Additional: []string{"Microsoft.Compute", "Microsoft.Network"},
+			Expected: map[string]bool{
+				"Microsoft.Compute": true,
+				"Microsoft.Network": true,
+			},
+		},
+		{
+			Name:       "Core",
+			Mode:       resourceProviderRegistrationsCore,
+			Additional: []string{"Microsoft.KeyVault"},
+			Expected: map[string]bool{
+				"Microsoft.Authorization": true,
+				"Microsoft.Compute":       true,
+				"Microsoft.KeyVault":      true,
+				"Microsoft.Network":       true,
+				"Microsoft.Resources":     true,
+				"Microsoft.Storage":       true,
+			},
+		},
+	}
+
+	for _, v := range cases {
+		actual := resourceProvidersForRegistration(v.Mode, v.Additional)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected the Resource Providers for %q to be %+v but got %+v", v.Name, v.Expected, actual)
+		}
+	}
+
+	// when registering everything, only the core namespaces and those explicitly specified are required
+	all := resourceProvidersForRegistration(resourceProviderRegistrationsAll, []string{"Microsoft.Insights", "Microsoft.Web"})
+	if len(all) != len(allResourceProviders)+1 {
+		t.Fatalf("Expected %d Resource Providers but got %d", len(allResourceProviders)+1, len(all))
+	}
+	for namespace, expected := range map[string]bool{"Microsoft.Compute": true, "microsoft.insights": true, "Microsoft.Web": true, "Microsoft.Cdn": false} {
+		if actual, ok := all[namespace]; !ok || actual != expected {
+			t.Fatalf("Expected %q to be included with required %t but got %t (included %t)", namespace, expected, actual, ok)
+		}
+	}
+}
+
+func TestDetermineAzureResourceProvidersToRegister(t *testing.T) {
+	providerList := []resources.Provider{
+		{
+			Namespace:         utils.String("Microsoft.Compute"),
+			RegistrationState: utils.String("Registered"),
+		},
+		{
+			Namespace:         utils.String("microsoft.network"),
+			RegistrationState: utils.String("Registered"),
+		},
+		{
+			Namespace:         utils.String("Microsoft.Storage"),
+			RegistrationState: utils.String("NotRegistered"),
+		},
+	}
+	providers := map[string]bool{
+		"Microsoft.Compute": true,
+		"Microsoft.Network": true,
+		"Microsoft.Storage": true,
+		"Microsoft.Cdn":     false,
+	}
+
+	actual := determineAzureResourceProvidersToRegister(providerList, providers)
+	expected := map[string]bool{
+		"Microsoft.Storage": true,
+		"Microsoft.Cdn":     false,
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected the Resource Providers to register to be %+v but got %+v", expected, actual)
+	}
+}
+
+func TestRegisterAzureResourceProvidersWithSubscription(t *testing.T) {
+	var mutex sync.Mutex
+	registered := make([]string, 0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+		namespace := segments[len(segments)-2]
+		if namespace == "Microsoft.Cdn" || namespace == "Microsoft.KeyVault" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		mutex.Lock()
+		registered = append(registered, namespace)
+		mutex.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"namespace":%q,"registrationState":"Registering"}`, namespace)
+	}))
+	defer server.Close()
+
+	client := resources.NewProvidersClientWithBaseURI(server.URL, fakearm.SubscriptionID)
+	client.RetryAttempts = 1
+
+	cases := []struct {
+		Name        string
+		Providers   map[string]bool
+		ExpectError bool
+	}{
+		{
+			Name: "Successful",
+			Providers: map[string]bool{
+				"Microsoft.Compute": true,
+				"Microsoft.Network": true,
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "Failure for an Optional Namespace",
+			Providers: map[string]bool{
+				"Microsoft.Compute": true,
+				"Microsoft.Cdn":     false,
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "Failures for Required Namespaces",
+			Providers: map[string]bool{
+				"Microsoft.Compute":  true,
+				"Microsoft.Cdn":      true,
+				"Microsoft.KeyVault": true,
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range cases {
+		err := registerAzureResourceProvidersWithSubscription(context.Background(), []resources.Provider{}, client, v.Providers)
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+			}
+
+			// all of the failures should be returned, rather than only the last one
+			for _, namespace := range []string{"Microsoft.Cdn", "Microsoft.KeyVault"} {
+				if !strings.Contains(err.Error(), namespace) {
+					t.Fatalf("Expected the error for %q to mention %q but got: %+v", v.Name, namespace, err)
+				}
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+		}
+	}
+
+	if len(registered) != 4 {
+		t.Fatalf("Expected 4 successful registrations but got %d: %+v", len(registered), registered)
+	}
+}
+
+// testUnitProviders returns a Provider configured to use the fake Resource Manager API, which allows
+// a Resource to be tested end-to-end (via `resource.UnitTest`) without an Azure Subscription
+func testUnitProviders(server *fakearm.Server) map[string]terraform.ResourceProvider {
+	provider := Provider().(*schema.Provider)
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		config := &authentication.Config{
+			SubscriptionID:                fakearm.SubscriptionID,
+			TenantID:                      fakearm.TenantID,
+			Environment:                   "public",
+			SkipCredentialsValidation:     true,
+			SkipProviderRegistration:      true,
+			CustomResourceManagerEndpoint: server.URL,
+			CustomAuthorizer:              autorest.NullAuthorizer{},
+		}
+
+		client, err := getArmClient(config)
+		if err != nil {
+			return nil, err
+		}
+
+		client.StopContext = provider.StopContext()
+		client.defaultTags = expandProviderDefaultTags(d)
+		client.ignoreTags = expandProviderIgnoreTags(d)
+		return client, nil
+	}
+
+	return map[string]terraform.ResourceProvider{
+		"azurerm": provider,
+	}
+}
+
+func testCheckFakeResourceExists(server *fakearm.Server, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		if _, exists := server.Get(rs.Primary.ID); !exists {
+			return fmt.Errorf("Bad: %q (ID %q) does not exist in the fake Resource Manager API", name, rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testCheckFakeResourcesDestroyed(server *fakearm.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if ids := server.ResourceIDs(); len(ids) > 0 {
+			return fmt.Errorf("Bad: the following Resources still exist in the fake Resource Manager API: %s", strings.Join(ids, ", "))
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,317 @@
+package azurerm
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const redactedValue = "[REDACTED]"
+
+// redactedHeaders are the HTTP Headers whose values are never written to the debug logs
+var redactedHeaders = []string{
+	"Authorization",
+	"x-ms-authorization-auxiliary",
+}
+
+// redactedQueryParameters are the Query String Parameters whose values are never written to the debug logs
+var redactedQueryParameters = []string{
+	// the signature of a Shared Access Signature, e.g. used to retrieve/set the Access Tier of a Storage Blob
+	"sig",
+}
+
+// redactedFields are the names of the fields within a request/response body whose values are never
+// written to the debug logs. In addition to these any attribute marked as `Sensitive` within the
+// Schema of a Resource or Data Source is redacted - see `sensitiveFieldNames`.
+var redactedFields = []string{
+	// Authentication
+	"access_token",
+	"client_assertion",
+	"client_secret",
+	"refresh_token",
+	"password",
+	"secret",
+
+	// Access Keys & Connection Strings (Storage, Service Bus, Event Hubs, Redis etc)
+	"keys",
+	"primaryKey",
+	"secondaryKey",
+	"primaryConnectionString",
+	"secondaryConnectionString",
+	"connectionString",
+	"aliasPrimaryConnectionString",
+	"aliasSecondaryConnectionString",
+	"accountKey",
+	"sasToken",
+
+	// Virtual Machines & Databases
+	"adminPassword",
+	"administratorLoginPassword",
+	"protectedSettings",
+	"customData",
+}
+
+var (
+	sensitiveFieldsOnce sync.Once
+	sensitiveFields     map[string]struct{}
+)
+
+// shouldLogBodies determines whether the body of each request/response should be written to the debug logs,
+// which can be disabled by setting the `ARM_LOG_BODIES` Environment Variable to `false`
+func shouldLogBodies() bool {
+	v := os.Getenv("ARM_LOG_BODIES")
+	if v == "" {
+		return true
+	}
+
+	logBodies, err := strconv.ParseBool(v)
+	if err != nil {
+		log.Printf("[WARN] Unable to parse the value %q for `ARM_LOG_BODIES` as a boolean - logging bodies", v)
+		return true
+	}
+
+	return logBodies
+}
+
+func withRequestLogging() autorest.SendDecorator {
+	logBodies := shouldLogBodies()
+
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			// dump request to wire format
+			if dump, err := dumpRedactedRequest(r, logBodies); err == nil {
+				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
+			} else {
+				// fallback to basic message
+				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, redactURL(r.URL))
+			}
+
+			resp, err := s.Do(r)
+			if resp != nil {
+				// dump response to wire format
+				if dump, err := dumpRedactedResponse(resp, logBodies); err == nil {
+					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", redactURL(r.URL), dump)
+				} else {
+					// fallback to basic message
+					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, redactURL(r.URL))
+				}
+			} else {
+				log.Printf("[DEBUG] Request to %s completed with no response", redactURL(r.URL))
+			}
+			return resp, err
+		})
+	}
+}
+
+func dumpRedactedRequest(r *http.Request, includeBody bool) ([]byte, error) {
+	// shallow copy the request so that the headers can be redacted without modifying the original
+	redacted := *r
+	redacted.Header = redactHeaders(r.Header)
+	if r.URL != nil {
+		uri := *r.URL
+		uri.RawQuery = redactQuery(r.URL.Query())
+		redacted.URL = &uri
+	}
+
+	dump, err := httputil.DumpRequestOut(&redacted, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !includeBody || r.Body == nil {
+		return dump, nil
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return append(dump, redactBody(body, r.Header.Get("Content-Type"))...), nil
+}
+
+func dumpRedactedResponse(resp *http.Response, includeBody bool) ([]byte, error) {
+	redacted := *resp
+	redacted.Header = redactHeaders(resp.Header)
+
+	dump, err := httputil.DumpResponse(&redacted, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !includeBody || resp.Body == nil {
+		return dump, nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	return append(dump, redactBody(body, resp.Header.Get("Content-Type"))...), nil
+}
+
+func redactHeaders(input http.Header) http.Header {
+	output := make(http.Header, len(input))
+	for k, v := range input {
+		output[k] = v
+	}
+
+	for _, k := range redactedHeaders {
+		if output.Get(k) != "" {
+			output.Set(k, redactedValue)
+		}
+	}
+
+	return output
+}
+
+// redactURL returns the URL with the values of any sensitive Query String Parameters redacted
+func redactURL(input *url.URL) string {
+	if input == nil {
+		return ""
+	}
+
+	output := *input
+	output.RawQuery = redactQuery(input.Query())
+	return output.String()
+}
+
+func redactQuery(input url.Values) string {
+	for _, k := range redactedQueryParameters {
+		if input.Get(k) != "" {
+			input.Set(k, redactedValue)
+		}
+	}
+
+	return input.Encode()
+}
+
+// redactBody returns a copy of the body with the values of any sensitive fields redacted
+func redactBody(body []byte, contentType string) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	if strings.HasPrefix(strings.ToLower(contentType), "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return []byte(redactedValue)
+		}
+
+		for k := range values {
+			if isSensitiveField(k) {
+				values.Set(k, redactedValue)
+			}
+		}
+
+		return []byte(values.Encode())
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		// this isn't something we know how to redact, so we return it as-is
+		return body
+	}
+
+	output, err := json.Marshal(redactJSON(parsed))
+	if err != nil {
+		return []byte(redactedValue)
+	}
+
+	return output
+}
+
+func redactJSON(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			// a string `value` holds a Key Vault Secret or an Access Key, however other `value` fields
+			// (such as the list of items returned from a List operation) are logged as normal
+			if strings.EqualFold(key, "value") {
+				if _, isString := val.(string); isString {
+					v[key] = redactedValue
+				} else {
+					v[key] = redactJSON(val)
+				}
+				continue
+			}
+
+			if isSensitiveField(key) {
+				v[key] = redactedValue
+				continue
+			}
+
+			v[key] = redactJSON(val)
+		}
+		return v
+
+	case []interface{}:
+		for i, val := range v {
+			v[i] = redactJSON(val)
+		}
+		return v
+	}
+
+	return input
+}
+
+func isSensitiveField(name string) bool {
+	_, sensitive := sensitiveFieldNames()[normalizeFieldName(name)]
+	return sensitive
+}
+
+// normalizeFieldName allows the snake_case field names used in the Schema to be matched against the
+// camelCase field names used in the API's
+func normalizeFieldName(input string) string {
+	return strings.ToLower(strings.Replace(input, "_", "", -1))
+}
+
+// sensitiveFieldNames returns the (normalized) names of all of the fields which should be redacted
+func sensitiveFieldNames() map[string]struct{} {
+	sensitiveFieldsOnce.Do(func() {
+		fields := make(map[string]struct{})
+		for _, v := range redactedFields {
+			fields[normalizeFieldName(v)] = struct{}{}
+		}
+
+		provider := Provider().(*schema.Provider)
+		for _, r := range provider.ResourcesMap {
+			collectSensitiveFieldNames(r.Schema, fields)
+		}
+		for _, r := range provider.DataSourcesMap {
+			collectSensitiveFieldNames(r.Schema, fields)
+		}
+
+		sensitiveFields = fields
+	})
+
+	return sensitiveFields
+}
+
+func collectSensitiveFieldNames(input map[string]*schema.Schema, fields map[string]struct{}) {
+	for k, v := range input {
+		if v.Sensitive {
+			fields[normalizeFieldName(k)] = struct{}{}
+		}
+
+		if nested, ok := v.Elem.(*schema.Resource); ok {
+			collectSensitiveFieldNames(nested.Schema, fields)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package azurerm
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := []struct {
+		Name        string
+		ContentType string
+		Input       string
+		Expected    string
+	}{
+		{
+			Name:        "Empty Body",
+			ContentType: "application/json",
+			Input:       "",
+			Expected:    "",
+		},
+		{
+			Name:        "Not JSON",
+			ContentType: "text/plain",
+			Input:       "hello world",
+			Expected:    "hello world",
+		},
+		{
+			Name:        "No Sensitive Fields",
+			ContentType: "application/json",
+			Input:       `{"location":"westeurope","name":"example"}`,
+			Expected:    `{"location":"westeurope","name":"example"}`,
+		},
+		{
+			Name:        "SQL Server Password",
+			ContentType: "application/json",
+			Input:       `{"properties":{"administratorLogin":"admin","administratorLoginPassword":"P@ssw0rd!"}}`,
+			Expected:    `{"properties":{"administratorLogin":"admin","administratorLoginPassword":"[REDACTED]"}}`,
+		},
+		{
+			Name:        "Storage Account Keys",
+			ContentType: "application/json",
+			Input:       `{"keys":[{"keyName":"key1","permissions":"FULL","value":"abc123"}]}`,
+			Expected:    `{"keys":"[REDACTED]"}`,
+		},
+		{
+			Name:        "Key Vault Secret",
+			ContentType: "application/json; charset=utf-8",
+			Input:       `{"id":"https://example.vault.azure.net/secrets/example","value":"szechuan"}`,
+			Expected:    `{"id":"https://example.vault.azure.net/secrets/example","value":"[REDACTED]"}`,
+		},
+		{
+			Name:        "List Response",
+			ContentType: "application/json",
+			Input:       `{"value":[{"name":"first"},{"name":"second","primaryConnectionString":"Endpoint=sb://example"}]}`,
+			Expected:    `{"value":[{"name":"first"},{"name":"second","primaryConnectionString":"[REDACTED]"}]}`,
+		},
+		{
+			Name:        "Sensitive Schema Attribute",
+			ContentType: "application/json",
+			Input:       `{"properties":{"instrumentationKey":"00000000-0000-0000-0000-000000000000"}}`,
+			Expected:    `{"properties":{"instrumentationKey":"[REDACTED]"}}`,
+		},
+		{
+			Name:        "Form Encoded",
+			ContentType: "application/x-www-form-urlencoded",
+			Input:       "client_id=abc&client_secret=def&grant_type=client_credentials",
+			Expected:    "client_id=abc&client_secret=%5BREDACTED%5D&grant_type=client_credentials",
+		},
+	}
+
+	for _, v := range cases {
+		actual := string(redactBody([]byte(v.Input), v.ContentType))
+		if actual != v.Expected {
+			t.Fatalf("Expected %q for %q but got %q", v.Expected, v.Name, actual)
+		}
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	input := http.Header{}
+	input.Set("Authorization", "Bearer abc123")
+	input.Set("Content-Type", "application/json")
+
+	output := redactHeaders(input)
+	if v := output.Get("Authorization"); v != redactedValue {
+		t.Fatalf("Expected the Authorization header to be redacted but got %q", v)
+	}
+	if v := output.Get("Content-Type"); v != "application/json" {
+		t.Fatalf("Expected the Content-Type header to be %q but got %q", "application/json", v)
+	}
+	if v := input.Get("Authorization"); v != "Bearer abc123" {
+		t.Fatalf("Expected the original Authorization header to be unchanged but got %q", v)
+	}
+}
+
+func TestWithRequestLogging(t *testing.T) {
+	cases := []struct {
+		Name            string
+		LogBodies       string
+		ExpectBodies    bool
+		ExpectedMissing []string
+	}{
+		{
+			Name:            "Bodies Logged",
+			LogBodies:       "",
+			ExpectBodies:    true,
+			ExpectedMissing: []string{"abc123", "P@ssw0rd!", "szechuan", "s3cr3t"},
+		},
+		{
+			Name:            "Bodies Not Logged",
+			LogBodies:       "false",
+			ExpectBodies:    false,
+			ExpectedMissing: []string{"abc123", "P@ssw0rd!", "szechuan", "s3cr3t", "westeurope"},
+		},
+	}
+
+	defer os.Setenv("ARM_LOG_BODIES", os.Getenv("ARM_LOG_BODIES"))
+	defer log.SetOutput(os.Stderr)
+
+	for _, v := range cases {
+		os.Setenv("ARM_LOG_BODIES", v.LogBodies)
+
+		var logs bytes.Buffer
+		log.SetOutput(&logs)
+
+		sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			body, _ := ioutil.ReadAll(r.Body)
+			if !strings.Contains(string(body), "P@ssw0rd!") {
+				t.Fatalf("Expected the request body sent for %q to be unmodified but got %q", v.Name, string(body))
+			}
+
+			return &http.Response{
+				Status:     "200 OK",
+				StatusCode: http.StatusOK,
+				Proto:      "HTTP/1.1",
+				ProtoMajor: 1,
+				ProtoMinor: 1,
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Body:       ioutil.NopCloser(strings.NewReader(`{"value":"szechuan"}`)),
+				Request:    r,
+			}, nil
+		})
+
+		req, _ := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000?sig=s3cr3t", strings.NewReader(`{"location":"westeurope","properties":{"administratorLoginPassword":"P@ssw0rd!"}}`))
+		req.Header.Set("Authorization", "Bearer abc123")
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := withRequestLogging()(sender).Do(req)
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+		}
+
+		body, _ := ioutil.ReadAll(resp.Body)
+		if string(body) != `{"value":"szechuan"}` {
+			t.Fatalf("Expected the response body for %q to be unmodified but got %q", v.Name, string(body))
+		}
+
+		output := logs.String()
+		for _, missing := range v.ExpectedMissing {
+			if strings.Contains(output, missing) {
+				t.Fatalf("Expected the logs for %q not to contain %q but got: %s", v.Name, missing, output)
+			}
+		}
+
+		if containsRedacted := strings.Contains(output, `"administratorLoginPassword":"[REDACTED]"`); containsRedacted != v.ExpectBodies {
+			t.Fatalf("Expected the logs for %q to contain the redacted body to be %t but got: %s", v.Name, v.ExpectBodies, output)
+		}
+	}
+}
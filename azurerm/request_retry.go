@@ -0,0 +1,193 @@
+package azurerm
+
+import (
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// retryBaseDelay is the delay before the first retry when the API doesn't specify a `Retry-After`,
+// which is doubled for each subsequent retry (up to the configured maximum)
+const retryBaseDelay = 2 * time.Second
+
+// retryableStatusCodes are the HTTP Status Codes returned from the API which indicate a transient failure
+var retryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// sdkRetryAttempts is the number of attempts the SDK makes for each request. The SDK's retries are applied on top of
+// the Sender (which retries requests itself) - so this only allows the SDK to send the request again once it's
+// registered a Resource Provider which the Subscription wasn't registered with
+const sdkRetryAttempts = 2
+
+// disableSdkRetries disables the retries which the SDK applies on top of the Sender - such that requests are only
+// retried by withRequestRetries, which limits the number of retries to `max_retries` and only retries requests
+// which are safe to retry
+func disableSdkRetries(client *autorest.Client) {
+	client.RetryAttempts = sdkRetryAttempts
+	client.RetryDuration = 0
+
+	// the SDK doesn't count retrying a throttled (429) request as an attempt, so the Status Codes it retries
+	// are cleared, rather than just limiting the number of attempts
+	autorest.StatusCodesForRetry = []int{}
+}
+
+// permanentRequestError wraps an error returned by withRequestRetries, which has either exhausted the retries
+// or determined that the request isn't safe to retry. The SDK retries any error which isn't a permanent
+// network error - so this is reported as one, such that the SDK doesn't retry the request again
+type permanentRequestError struct {
+	err error
+}
+
+func (e permanentRequestError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentRequestError) Timeout() bool {
+	if netErr, ok := e.err.(net.Error); ok {
+		return netErr.Timeout()
+	}
+
+	return false
+}
+
+func (e permanentRequestError) Temporary() bool {
+	return false
+}
+
+// withRequestRetries returns a SendDecorator which retries requests which were throttled (429) or failed
+// with a transient error (5xx / a temporary network error) up to `maxRetries` times. The delay between
+// each attempt is taken from the `Retry-After` header where specified, otherwise an exponential backoff
+// with jitter is used - in both cases the delay is capped at `maxWait`.
+func withRequestRetries(maxRetries int, maxWait time.Duration) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (resp *http.Response, err error) {
+			rr := autorest.NewRetriableRequest(r)
+			for attempt := 0; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, permanentRequestError{err}
+				}
+
+				resp, err = s.Do(rr.Request())
+				if attempt >= maxRetries || !shouldRetryRequest(r, resp, err) {
+					if err != nil {
+						return resp, permanentRequestError{err}
+					}
+					return resp, nil
+				}
+
+				delay := retryDelay(resp, attempt, maxWait)
+				log.Printf("[DEBUG] Retrying %s request to %s in %s (attempt %d of %d)", r.Method, redactURL(r.URL), delay, attempt+1, maxRetries)
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					if err != nil {
+						return resp, permanentRequestError{err}
+					}
+					return resp, nil
+				}
+
+				// the response is being discarded, so the connection needs to be released before we try again
+				if resp != nil && resp.Body != nil {
+					io.Copy(ioutil.Discard, resp.Body)
+					resp.Body.Close()
+				}
+			}
+		})
+	}
+}
+
+// shouldRetryRequest determines whether the request should be retried based on the response/error
+func shouldRetryRequest(r *http.Request, resp *http.Response, err error) bool {
+	// a throttled request hasn't been processed, so it's always safe to try again
+	if err == nil && resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	// however other failures may have been partially processed, so we only retry idempotent requests
+	if !isIdempotentMethod(r.Method) {
+		return false
+	}
+
+	if err != nil {
+		return utils.ResponseErrorIsRetryable(err)
+	}
+
+	return autorest.ResponseHasStatusCode(resp, retryableStatusCodes...)
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// retryDelay returns how long to wait before the next attempt, which is taken from the `Retry-After`
+// header if present - otherwise an exponential backoff with jitter is used
+func retryDelay(resp *http.Response, attempt int, maxWait time.Duration) time.Duration {
+	if delay, ok := parseRetryAfter(resp); ok {
+		if delay > maxWait {
+			return maxWait
+		}
+		return delay
+	}
+
+	// cap the exponent to avoid overflowing - this is well beyond any reasonable `maxWait`
+	backoff := time.Duration(math.Pow(2, math.Min(float64(attempt), 16))) * retryBaseDelay
+	if backoff > maxWait {
+		backoff = maxWait
+	}
+
+	// "equal jitter" - wait for at least half of the backoff, plus a random amount up to the other half
+	half := int64(backoff / 2)
+	if half <= 0 {
+		return backoff
+	}
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// parseRetryAfter parses the `Retry-After` header, which can either be a number of seconds or a HTTP Date
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(v); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
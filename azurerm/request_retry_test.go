@@ -0,0 +1,324 @@
+package azurerm
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+)
+
+func TestWithRequestRetries(t *testing.T) {
+	cases := []struct {
+		Name             string
+		Method           string
+		Responses        []int
+		RetryAfter       string
+		MaxRetries       int
+		ExpectedStatus   int
+		ExpectedRequests int32
+	}{
+		{
+			Name:             "Success",
+			Method:           http.MethodGet,
+			Responses:        []int{http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Throttled then Success",
+			Method:           http.MethodPut,
+			Responses:        []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			RetryAfter:       "0",
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "Throttled POST is Retried",
+			Method:           http.MethodPost,
+			Responses:        []int{http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 2,
+		},
+		{
+			Name:             "Transient Failures then Success",
+			Method:           http.MethodDelete,
+			Responses:        []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 4,
+		},
+		{
+			Name:             "Transient Failure on POST isn't Retried",
+			Method:           http.MethodPost,
+			Responses:        []int{http.StatusServiceUnavailable, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusServiceUnavailable,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Client Error isn't Retried",
+			Method:           http.MethodGet,
+			Responses:        []int{http.StatusBadRequest, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedStatus:   http.StatusBadRequest,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Retries Exhausted",
+			Method:           http.MethodGet,
+			Responses:        []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			MaxRetries:       2,
+			ExpectedStatus:   http.StatusServiceUnavailable,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "Retries Disabled",
+			Method:           http.MethodGet,
+			Responses:        []int{http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries:       0,
+			ExpectedStatus:   http.StatusTooManyRequests,
+			ExpectedRequests: 1,
+		},
+	}
+
+	for _, v := range cases {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			if r.Method != http.MethodGet && string(body) != `{"location":"westeurope"}` {
+				t.Errorf("Expected the request body for %q to be replayed but got %q", v.Name, string(body))
+			}
+
+			i := atomic.AddInt32(&requests, 1) - 1
+			if v.RetryAfter != "" {
+				w.Header().Set("Retry-After", v.RetryAfter)
+			}
+			w.WriteHeader(v.Responses[i])
+		}))
+
+		var body *strings.Reader
+		if v.Method != http.MethodGet {
+			body = strings.NewReader(`{"location":"westeurope"}`)
+		} else {
+			body = strings.NewReader("")
+		}
+		req, _ := http.NewRequest(v.Method, server.URL, body)
+
+		sender := autorest.CreateSender(withRequestRetries(v.MaxRetries, 10*time.Millisecond))
+		resp, err := sender.Do(req)
+		server.Close()
+
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.Name, err)
+		}
+		if resp.StatusCode != v.ExpectedStatus {
+			t.Fatalf("Expected the status code for %q to be %d but got %d", v.Name, v.ExpectedStatus, resp.StatusCode)
+		}
+		if requests != v.ExpectedRequests {
+			t.Fatalf("Expected %d requests for %q but got %d", v.ExpectedRequests, v.Name, requests)
+		}
+	}
+}
+
+func TestWithRequestRetriesCancelled(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	req = req.WithContext(ctx)
+
+	start := time.Now()
+	sender := autorest.CreateSender(withRequestRetries(5, time.Minute))
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Expected the status code to be %d but got %d", http.StatusTooManyRequests, resp.StatusCode)
+	}
+	if requests != 1 {
+		t.Fatalf("Expected 1 request but got %d", requests)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("Expected retrying to stop when the context was cancelled but it took %s", elapsed)
+	}
+}
+
+func TestWithRequestRetriesSdkClient(t *testing.T) {
+	cases := []struct {
+		Name             string
+		Method           string
+		Status           int
+		MaxRetries       int
+		ExpectedRequests int32
+	}{
+		{
+			Name:             "Retries Disabled",
+			Method:           http.MethodGet,
+			Status:           http.StatusServiceUnavailable,
+			MaxRetries:       0,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Retries Exhausted",
+			Method:           http.MethodGet,
+			Status:           http.StatusServiceUnavailable,
+			MaxRetries:       2,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "Throttled Retries Exhausted",
+			Method:           http.MethodGet,
+			Status:           http.StatusTooManyRequests,
+			MaxRetries:       2,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "Transient Failure on POST isn't Retried",
+			Method:           http.MethodPost,
+			Status:           http.StatusServiceUnavailable,
+			MaxRetries:       2,
+			ExpectedRequests: 1,
+		},
+	}
+
+	for _, v := range cases {
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != v.Method {
+				t.Errorf("Expected a %s request for %q but got a %s request", v.Method, v.Name, r.Method)
+			}
+
+			atomic.AddInt32(&requests, 1)
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(v.Status)
+		}))
+
+		armClient := &ArmClient{
+			maxRetries:   v.MaxRetries,
+			maxRetryWait: 10 * time.Millisecond,
+		}
+		client := resources.NewGroupsClientWithBaseURI(server.URL, fakearm.SubscriptionID)
+		// any retries made by the SDK would otherwise be delayed beyond the timeout below
+		client.RetryDuration = time.Millisecond
+		armClient.configureClient(&client.Client, autorest.NullAuthorizer{})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		var err error
+		if v.Method == http.MethodPost {
+			_, err = client.ExportTemplate(ctx, "example", resources.ExportTemplateRequest{
+				ResourcesProperty: &[]string{"*"},
+			})
+		} else {
+			_, err = client.Get(ctx, "example")
+		}
+		cancel()
+		server.Close()
+
+		if err == nil {
+			t.Fatalf("Expected an error for %q but didn't get one", v.Name)
+		}
+		if requests != v.ExpectedRequests {
+			t.Fatalf("Expected %d requests for %q but got %d", v.ExpectedRequests, v.Name, requests)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	cases := []struct {
+		Name       string
+		RetryAfter string
+		Attempt    int
+		MaxWait    time.Duration
+		Minimum    time.Duration
+		Maximum    time.Duration
+	}{
+		{
+			Name:    "First Attempt",
+			Attempt: 0,
+			MaxWait: time.Minute,
+			Minimum: retryBaseDelay / 2,
+			Maximum: retryBaseDelay,
+		},
+		{
+			Name:    "Third Attempt",
+			Attempt: 2,
+			MaxWait: time.Minute,
+			Minimum: 2 * retryBaseDelay,
+			Maximum: 4 * retryBaseDelay,
+		},
+		{
+			Name:    "Backoff Capped",
+			Attempt: 50,
+			MaxWait: time.Minute,
+			Minimum: 30 * time.Second,
+			Maximum: time.Minute,
+		},
+		{
+			Name:       "Retry-After Seconds",
+			RetryAfter: "17",
+			Attempt:    3,
+			MaxWait:    time.Minute,
+			Minimum:    17 * time.Second,
+			Maximum:    17 * time.Second,
+		},
+		{
+			Name:       "Retry-After Capped",
+			RetryAfter: "3600",
+			Attempt:    0,
+			MaxWait:    time.Minute,
+			Minimum:    time.Minute,
+			Maximum:    time.Minute,
+		},
+		{
+			Name:       "Retry-After Date",
+			RetryAfter: time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat),
+			Attempt:    0,
+			MaxWait:    time.Minute,
+			Minimum:    28 * time.Second,
+			Maximum:    30 * time.Second,
+		},
+		{
+			Name:       "Retry-After Invalid",
+			RetryAfter: "soon",
+			Attempt:    0,
+			MaxWait:    time.Minute,
+			Minimum:    retryBaseDelay / 2,
+			Maximum:    retryBaseDelay,
+		},
+	}
+
+	for _, v := range cases {
+		resp := &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{},
+		}
+		if v.RetryAfter != "" {
+			resp.Header.Set("Retry-After", v.RetryAfter)
+		}
+
+		actual := retryDelay(resp, v.Attempt, v.MaxWait)
+		if actual < v.Minimum || actual > v.Maximum {
+			t.Fatalf("Expected the delay for %q to be between %s and %s but got %s", v.Name, v.Minimum, v.Maximum, actual)
+		}
+	}
+}
@@ -1,13 +1,21 @@
 package azurerm
 
 import (
+	"bytes"
+	"context"
 	"fmt"
-	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"log"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -21,6 +29,13 @@ func resourceArmActiveDirectoryApplication() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -66,6 +81,155 @@ func resourceArmActiveDirectoryApplication() *schema.Resource {
 				Optional: true,
 			},
 
+			"app_role": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Set:      resourceArmActiveDirectoryApplicationAppRoleHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"allowed_member_types": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Application",
+									"User",
+								}, false),
+							},
+						},
+
+						"description": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"display_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"is_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"oauth2_permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Set:      resourceArmActiveDirectoryApplicationOAuth2PermissionHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"admin_consent_description": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"admin_consent_display_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"is_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Admin",
+								"User",
+							}, false),
+						},
+
+						"user_consent_description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"user_consent_display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"required_resource_access": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_app_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.UUID,
+						},
+
+						"resource_access": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.UUID,
+									},
+
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Role",
+											"Scope",
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"application_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -76,7 +240,8 @@ func resourceArmActiveDirectoryApplication() *schema.Resource {
 
 func resourceArmActiveDirectoryApplicationCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	availableToOtherTenants := d.Get("available_to_other_tenants").(bool)
@@ -100,12 +265,17 @@ func resourceArmActiveDirectoryApplicationCreate(d *schema.ResourceData, meta in
 
 	d.SetId(*app.ObjectID)
 
+	if err := resourceArmActiveDirectoryApplicationUpdateProperties(ctx, d, meta); err != nil {
+		return err
+	}
+
 	return resourceArmActiveDirectoryApplicationRead(d, meta)
 }
 
 func resourceArmActiveDirectoryApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 
@@ -142,12 +312,69 @@ func resourceArmActiveDirectoryApplicationUpdate(d *schema.ResourceData, meta in
 		return fmt.Errorf("Error patching Azure AD Application with ID %q: %+v", d.Id(), err)
 	}
 
+	if err := resourceArmActiveDirectoryApplicationUpdateProperties(ctx, d, meta); err != nil {
+		return err
+	}
+
 	return resourceArmActiveDirectoryApplicationRead(d, meta)
 }
 
+// resourceArmActiveDirectoryApplicationUpdateProperties updates the App Roles, OAuth2 Permissions and Required
+// Resource Access of the Application, which (when newly created) only applies those which have been specified
+func resourceArmActiveDirectoryApplicationUpdateProperties(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).applicationPropertiesClient
+
+	hasChange := func(key string) bool {
+		if d.IsNewResource() {
+			_, ok := d.GetOk(key)
+			return ok
+		}
+
+		return d.HasChange(key)
+	}
+
+	if !hasChange("app_role") && !hasChange("oauth2_permissions") && !hasChange("required_resource_access") {
+		return nil
+	}
+
+	existing, err := client.Get(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving Azure AD Application with ID %q: %+v", d.Id(), err)
+	}
+
+	var properties azureADApplicationProperties
+
+	if hasChange("app_role") {
+		properties.AppRoles = expandAzureADApplicationAppRoles(d.Get("app_role").(*schema.Set).List(), existing.AppRoles)
+	}
+
+	if hasChange("oauth2_permissions") {
+		properties.OAuth2Permissions = expandAzureADApplicationOAuth2Permissions(d.Get("oauth2_permissions").(*schema.Set).List(), existing.OAuth2Permissions)
+	}
+
+	if hasChange("required_resource_access") {
+		properties.RequiredResourceAccess = expandAzureADApplicationRequiredResourceAccess(d.Get("required_resource_access").(*schema.Set).List())
+	}
+
+	// App Roles and OAuth2 Permissions have to be disabled before they can be removed
+	if disabled := azureADApplicationDisableRemovedProperties(existing, properties); disabled != nil {
+		log.Printf("[DEBUG] Disabling the App Roles/OAuth2 Permissions being removed from Azure AD Application with ID %q", d.Id())
+		if _, err := client.Patch(ctx, d.Id(), *disabled); err != nil {
+			return fmt.Errorf("Error disabling App Roles/OAuth2 Permissions for Azure AD Application with ID %q: %+v", d.Id(), err)
+		}
+	}
+
+	if _, err := client.Patch(ctx, d.Id(), properties); err != nil {
+		return fmt.Errorf("Error updating App Roles/OAuth2 Permissions/Required Resource Access for Azure AD Application with ID %q: %+v", d.Id(), err)
+	}
+
+	return nil
+}
+
 func resourceArmActiveDirectoryApplicationRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.Get(ctx, d.Id())
 	if err != nil {
@@ -176,12 +403,30 @@ func resourceArmActiveDirectoryApplicationRead(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error setting `reply_urls`: %+v", err)
 	}
 
+	properties, err := meta.(*ArmClient).applicationPropertiesClient.Get(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error retrieving App Roles/OAuth2 Permissions for Azure AD Application with ID %q: %+v", d.Id(), err)
+	}
+
+	if err := d.Set("app_role", flattenAzureADApplicationAppRoles(properties.AppRoles)); err != nil {
+		return fmt.Errorf("Error setting `app_role`: %+v", err)
+	}
+
+	if err := d.Set("oauth2_permissions", flattenAzureADApplicationOAuth2Permissions(properties.OAuth2Permissions)); err != nil {
+		return fmt.Errorf("Error setting `oauth2_permissions`: %+v", err)
+	}
+
+	if err := d.Set("required_resource_access", flattenAzureADApplicationRequiredResourceAccess(properties.RequiredResourceAccess)); err != nil {
+		return fmt.Errorf("Error setting `required_resource_access`: %+v", err)
+	}
+
 	return nil
 }
 
 func resourceArmActiveDirectoryApplicationDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	// in order to delete an application which is available to other tenants, we first have to disable this setting
 	availableToOtherTenants := d.Get("available_to_other_tenants").(bool)
@@ -259,3 +504,320 @@ func flattenAzureADApplicationReplyUrls(input *[]string) []string {
 
 	return output
 }
+
+func expandAzureADApplicationAppRoles(input []interface{}, existing *[]azureADApplicationAppRole) *[]azureADApplicationAppRole {
+	// App Roles are identified by their Value, so we retain the ID of any existing App Role with the same Value
+	existingIds := make(map[string]string)
+	if existing != nil {
+		for _, v := range *existing {
+			if v.ID != nil && v.Value != nil {
+				existingIds[*v.Value] = *v.ID
+			}
+		}
+	}
+
+	output := make([]azureADApplicationAppRole, 0)
+	for _, v := range input {
+		role := v.(map[string]interface{})
+		value := role["value"].(string)
+
+		id, ok := existingIds[value]
+		if !ok {
+			id = uuid.New().String()
+		}
+
+		allowedMemberTypes := make([]string, 0)
+		for _, t := range role["allowed_member_types"].(*schema.Set).List() {
+			allowedMemberTypes = append(allowedMemberTypes, t.(string))
+		}
+		sort.Strings(allowedMemberTypes)
+
+		output = append(output, azureADApplicationAppRole{
+			ID:                 utils.String(id),
+			AllowedMemberTypes: &allowedMemberTypes,
+			Description:        utils.String(role["description"].(string)),
+			DisplayName:        utils.String(role["display_name"].(string)),
+			IsEnabled:          utils.Bool(role["is_enabled"].(bool)),
+			Value:              utils.String(value),
+		})
+	}
+
+	return &output
+}
+
+func flattenAzureADApplicationAppRoles(input *[]azureADApplicationAppRole) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		role := make(map[string]interface{})
+
+		if v.ID != nil {
+			role["id"] = *v.ID
+		}
+
+		allowedMemberTypes := make([]interface{}, 0)
+		if v.AllowedMemberTypes != nil {
+			for _, t := range *v.AllowedMemberTypes {
+				allowedMemberTypes = append(allowedMemberTypes, t)
+			}
+		}
+		role["allowed_member_types"] = schema.NewSet(schema.HashString, allowedMemberTypes)
+
+		if v.Description != nil {
+			role["description"] = *v.Description
+		}
+
+		if v.DisplayName != nil {
+			role["display_name"] = *v.DisplayName
+		}
+
+		if v.IsEnabled != nil {
+			role["is_enabled"] = *v.IsEnabled
+		}
+
+		if v.Value != nil {
+			role["value"] = *v.Value
+		}
+
+		output = append(output, role)
+	}
+
+	return output
+}
+
+func expandAzureADApplicationOAuth2Permissions(input []interface{}, existing *[]azureADApplicationOAuth2Permission) *[]azureADApplicationOAuth2Permission {
+	// OAuth2 Permissions are identified by their Value, so we retain the ID of any existing Permission with the same Value
+	existingIds := make(map[string]string)
+	if existing != nil {
+		for _, v := range *existing {
+			if v.ID != nil && v.Value != nil {
+				existingIds[*v.Value] = *v.ID
+			}
+		}
+	}
+
+	output := make([]azureADApplicationOAuth2Permission, 0)
+	for _, v := range input {
+		permission := v.(map[string]interface{})
+		value := permission["value"].(string)
+
+		id, ok := existingIds[value]
+		if !ok {
+			id = uuid.New().String()
+		}
+
+		output = append(output, azureADApplicationOAuth2Permission{
+			ID:                      utils.String(id),
+			AdminConsentDescription: utils.String(permission["admin_consent_description"].(string)),
+			AdminConsentDisplayName: utils.String(permission["admin_consent_display_name"].(string)),
+			IsEnabled:               utils.Bool(permission["is_enabled"].(bool)),
+			Type:                    utils.String(permission["type"].(string)),
+			UserConsentDescription:  utils.String(permission["user_consent_description"].(string)),
+			UserConsentDisplayName:  utils.String(permission["user_consent_display_name"].(string)),
+			Value:                   utils.String(value),
+		})
+	}
+
+	return &output
+}
+
+func flattenAzureADApplicationOAuth2Permissions(input *[]azureADApplicationOAuth2Permission) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		permission := make(map[string]interface{})
+
+		if v.ID != nil {
+			permission["id"] = *v.ID
+		}
+
+		if v.AdminConsentDescription != nil {
+			permission["admin_consent_description"] = *v.AdminConsentDescription
+		}
+
+		if v.AdminConsentDisplayName != nil {
+			permission["admin_consent_display_name"] = *v.AdminConsentDisplayName
+		}
+
+		if v.IsEnabled != nil {
+			permission["is_enabled"] = *v.IsEnabled
+		}
+
+		if v.Type != nil {
+			permission["type"] = *v.Type
+		}
+
+		if v.UserConsentDescription != nil {
+			permission["user_consent_description"] = *v.UserConsentDescription
+		}
+
+		if v.UserConsentDisplayName != nil {
+			permission["user_consent_display_name"] = *v.UserConsentDisplayName
+		}
+
+		if v.Value != nil {
+			permission["value"] = *v.Value
+		}
+
+		output = append(output, permission)
+	}
+
+	return output
+}
+
+func expandAzureADApplicationRequiredResourceAccess(input []interface{}) *[]graphrbac.RequiredResourceAccess {
+	output := make([]graphrbac.RequiredResourceAccess, 0)
+
+	for _, v := range input {
+		requiredResourceAccess := v.(map[string]interface{})
+
+		resourceAccess := make([]graphrbac.ResourceAccess, 0)
+		for _, a := range requiredResourceAccess["resource_access"].([]interface{}) {
+			access := a.(map[string]interface{})
+			resourceAccess = append(resourceAccess, graphrbac.ResourceAccess{
+				ID:   utils.String(access["id"].(string)),
+				Type: utils.String(access["type"].(string)),
+			})
+		}
+
+		output = append(output, graphrbac.RequiredResourceAccess{
+			ResourceAppID:  utils.String(requiredResourceAccess["resource_app_id"].(string)),
+			ResourceAccess: &resourceAccess,
+		})
+	}
+
+	return &output
+}
+
+func flattenAzureADApplicationRequiredResourceAccess(input *[]graphrbac.RequiredResourceAccess) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		requiredResourceAccess := make(map[string]interface{})
+
+		if v.ResourceAppID != nil {
+			requiredResourceAccess["resource_app_id"] = *v.ResourceAppID
+		}
+
+		resourceAccess := make([]interface{}, 0)
+		if v.ResourceAccess != nil {
+			for _, a := range *v.ResourceAccess {
+				access := make(map[string]interface{})
+				if a.ID != nil {
+					access["id"] = *a.ID
+				}
+				if a.Type != nil {
+					access["type"] = *a.Type
+				}
+				resourceAccess = append(resourceAccess, access)
+			}
+		}
+		requiredResourceAccess["resource_access"] = resourceAccess
+
+		output = append(output, requiredResourceAccess)
+	}
+
+	return output
+}
+
+// azureADApplicationDisableRemovedProperties returns the existing App Roles and OAuth2 Permissions with those which
+// aren't present in the updated properties disabled - or nil if there's nothing to disable
+func azureADApplicationDisableRemovedProperties(existing azureADApplicationProperties, updated azureADApplicationProperties) *azureADApplicationProperties {
+	var output azureADApplicationProperties
+	requiresUpdate := false
+
+	if existing.AppRoles != nil && updated.AppRoles != nil {
+		ids := make(map[string]struct{})
+		for _, v := range *updated.AppRoles {
+			ids[*v.ID] = struct{}{}
+		}
+
+		roles := make([]azureADApplicationAppRole, 0)
+		for _, v := range *existing.AppRoles {
+			if v.ID == nil {
+				continue
+			}
+
+			if _, ok := ids[*v.ID]; !ok && (v.IsEnabled == nil || *v.IsEnabled) {
+				v.IsEnabled = utils.Bool(false)
+				requiresUpdate = true
+			}
+			roles = append(roles, v)
+		}
+		output.AppRoles = &roles
+	}
+
+	if existing.OAuth2Permissions != nil && updated.OAuth2Permissions != nil {
+		ids := make(map[string]struct{})
+		for _, v := range *updated.OAuth2Permissions {
+			ids[*v.ID] = struct{}{}
+		}
+
+		permissions := make([]azureADApplicationOAuth2Permission, 0)
+		for _, v := range *existing.OAuth2Permissions {
+			if v.ID == nil {
+				continue
+			}
+
+			if _, ok := ids[*v.ID]; !ok && (v.IsEnabled == nil || *v.IsEnabled) {
+				v.IsEnabled = utils.Bool(false)
+				requiresUpdate = true
+			}
+			permissions = append(permissions, v)
+		}
+		output.OAuth2Permissions = &permissions
+	}
+
+	if !requiresUpdate {
+		return nil
+	}
+
+	return &output
+}
+
+func resourceArmActiveDirectoryApplicationAppRoleHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		allowedMemberTypes := make([]string, 0)
+		if s, ok := m["allowed_member_types"].(*schema.Set); ok {
+			for _, t := range s.List() {
+				allowedMemberTypes = append(allowedMemberTypes, t.(string))
+			}
+		}
+		sort.Strings(allowedMemberTypes)
+
+		buf.WriteString(fmt.Sprintf("%s-", strings.Join(allowedMemberTypes, ",")))
+		buf.WriteString(fmt.Sprintf("%s-", m["description"].(string)))
+		buf.WriteString(fmt.Sprintf("%s-", m["display_name"].(string)))
+		buf.WriteString(fmt.Sprintf("%t-", m["is_enabled"].(bool)))
+		buf.WriteString(fmt.Sprintf("%s-", m["value"].(string)))
+	}
+
+	return hashcode.String(buf.String())
+}
+
+func resourceArmActiveDirectoryApplicationOAuth2PermissionHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", m["admin_consent_description"].(string)))
+		buf.WriteString(fmt.Sprintf("%s-", m["admin_consent_display_name"].(string)))
+		buf.WriteString(fmt.Sprintf("%t-", m["is_enabled"].(bool)))
+		buf.WriteString(fmt.Sprintf("%s-", m["type"].(string)))
+		buf.WriteString(fmt.Sprintf("%s-", m["user_consent_description"].(string)))
+		buf.WriteString(fmt.Sprintf("%s-", m["user_consent_display_name"].(string)))
+		buf.WriteString(fmt.Sprintf("%s-", m["value"].(string)))
+	}
+
+	return hashcode.String(buf.String())
+}
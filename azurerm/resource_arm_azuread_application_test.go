@@ -2,10 +2,12 @@ package azurerm
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -131,6 +133,145 @@ func TestAccAzureRMActiveDirectoryApplication_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMActiveDirectoryApplication_appRolesAndPermissions(t *testing.T) {
+	resourceName := "azurerm_azuread_application.test"
+	id := uuid.New().String()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMActiveDirectoryApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMActiveDirectoryApplication_appRolesAndPermissions(id, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "app_role.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "oauth2_permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "required_resource_access.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// removing an App Role requires it to be disabled first
+				Config: testAccAzureRMActiveDirectoryApplication_appRolesAndPermissions(id, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "app_role.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "oauth2_permissions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "required_resource_access.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandAzureADApplicationAppRoles(t *testing.T) {
+	existing := []azureADApplicationAppRole{
+		{
+			ID:    utils.String("00000000-0000-0000-0000-000000000000"),
+			Value: utils.String("Reader"),
+		},
+	}
+	input := []interface{}{
+		map[string]interface{}{
+			"allowed_member_types": schema.NewSet(schema.HashString, []interface{}{"User", "Application"}),
+			"description":          "Readers can read",
+			"display_name":         "Reader",
+			"is_enabled":           true,
+			"value":                "Reader",
+		},
+		map[string]interface{}{
+			"allowed_member_types": schema.NewSet(schema.HashString, []interface{}{"User"}),
+			"description":          "Writers can write",
+			"display_name":         "Writer",
+			"is_enabled":           false,
+			"value":                "Writer",
+		},
+	}
+
+	actual := *expandAzureADApplicationAppRoles(input, &existing)
+	if len(actual) != 2 {
+		t.Fatalf("Expected 2 App Roles but got %d", len(actual))
+	}
+
+	// the ID of the existing App Role with the same Value is retained
+	if *actual[0].ID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected the ID of the existing App Role to be retained but got %q", *actual[0].ID)
+	}
+	if types := strings.Join(*actual[0].AllowedMemberTypes, ","); types != "Application,User" {
+		t.Fatalf("Expected the Allowed Member Types to be %q but got %q", "Application,User", types)
+	}
+
+	if *actual[1].ID == "" || *actual[1].ID == *actual[0].ID {
+		t.Fatalf("Expected a new ID to be generated for the new App Role but got %q", *actual[1].ID)
+	}
+	if *actual[1].IsEnabled {
+		t.Fatalf("Expected the new App Role to be disabled")
+	}
+}
+
+func TestAzureADApplicationDisableRemovedProperties(t *testing.T) {
+	existing := azureADApplicationProperties{
+		AppRoles: &[]azureADApplicationAppRole{
+			{
+				ID:        utils.String("00000000-0000-0000-0000-000000000000"),
+				IsEnabled: utils.Bool(true),
+			},
+			{
+				ID:        utils.String("11111111-1111-1111-1111-111111111111"),
+				IsEnabled: utils.Bool(true),
+			},
+		},
+		OAuth2Permissions: &[]azureADApplicationOAuth2Permission{
+			{
+				ID:        utils.String("22222222-2222-2222-2222-222222222222"),
+				IsEnabled: utils.Bool(true),
+			},
+		},
+	}
+
+	// nothing is being removed
+	unchanged := azureADApplicationProperties{
+		AppRoles:          existing.AppRoles,
+		OAuth2Permissions: existing.OAuth2Permissions,
+	}
+	if actual := azureADApplicationDisableRemovedProperties(existing, unchanged); actual != nil {
+		t.Fatalf("Expected nothing to be disabled but got %+v", *actual)
+	}
+
+	// the OAuth2 Permissions aren't being updated, so should be left as-is
+	updated := azureADApplicationProperties{
+		AppRoles: &[]azureADApplicationAppRole{
+			{
+				ID: utils.String("11111111-1111-1111-1111-111111111111"),
+			},
+		},
+	}
+	actual := azureADApplicationDisableRemovedProperties(existing, updated)
+	if actual == nil {
+		t.Fatalf("Expected the removed App Role to be disabled but got nil")
+	}
+	if actual.OAuth2Permissions != nil {
+		t.Fatalf("Expected the OAuth2 Permissions to be nil but got %+v", *actual.OAuth2Permissions)
+	}
+
+	roles := *actual.AppRoles
+	if len(roles) != 2 {
+		t.Fatalf("Expected 2 App Roles but got %d", len(roles))
+	}
+	if *roles[0].IsEnabled {
+		t.Fatalf("Expected the removed App Role %q to be disabled", *roles[0].ID)
+	}
+	if !*roles[1].IsEnabled {
+		t.Fatalf("Expected the retained App Role %q to be enabled", *roles[1].ID)
+	}
+}
+
 func testCheckAzureRMActiveDirectoryApplicationExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -206,3 +347,51 @@ resource "azurerm_azuread_application" "test" {
 }
 `, id, id, id, id)
 }
+
+func testAccAzureRMActiveDirectoryApplication_appRolesAndPermissions(id string, includeWriter bool) string {
+	writer := ""
+	if includeWriter {
+		writer = `
+  app_role {
+    allowed_member_types = ["User"]
+    description          = "Writers can write"
+    display_name         = "Writer"
+    value                = "Writer"
+  }
+`
+	}
+
+	return fmt.Sprintf(`
+resource "azurerm_azuread_application" "test" {
+  name            = "acctest%s"
+  identifier_uris = ["https://%s.hashicorptest.com"]
+
+  app_role {
+    allowed_member_types = ["User", "Application"]
+    description          = "Readers can read"
+    display_name         = "Reader"
+    value                = "Reader"
+  }
+%s
+  oauth2_permissions {
+    admin_consent_description  = "Allow the application to access the API on behalf of the signed-in user"
+    admin_consent_display_name = "Access the API"
+    type                       = "User"
+    user_consent_description   = "Allow the application to access the API on your behalf"
+    user_consent_display_name  = "Access the API"
+    value                      = "user_impersonation"
+  }
+
+  required_resource_access {
+    # Windows Azure Active Directory
+    resource_app_id = "00000002-0000-0000-c000-000000000000"
+
+    # Sign in and read user profile
+    resource_access {
+      id   = "311a71cc-e848-46a1-bdf8-97ff7156d8e6"
+      type = "Scope"
+    }
+  }
+}
+`, id, id, writer)
+}
@@ -0,0 +1,116 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var azureADGroupResourceName = "azurerm_azuread_group"
+
+func resourceArmActiveDirectoryGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmActiveDirectoryGroupCreate,
+		Read:   resourceArmActiveDirectoryGroupRead,
+		Delete: resourceArmActiveDirectoryGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		// Groups can't be updated using version 1.6 of the Graph API
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmActiveDirectoryGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).groupsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+
+	// a Mail Nickname is required by the API even though the Group isn't Mail Enabled
+	properties := graphrbac.GroupCreateParameters{
+		DisplayName:     utils.String(name),
+		MailEnabled:     utils.Bool(false),
+		MailNickname:    utils.String(uuid.New().String()),
+		SecurityEnabled: utils.Bool(true),
+	}
+
+	group, err := client.Create(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("Error creating Azure AD Group %q: %+v", name, err)
+	}
+
+	if group.ObjectID == nil {
+		return fmt.Errorf("Error creating Azure AD Group %q: Object ID was nil", name)
+	}
+
+	d.SetId(*group.ObjectID)
+
+	return resourceArmActiveDirectoryGroupRead(d, meta)
+}
+
+func resourceArmActiveDirectoryGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).groupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	objectId := d.Id()
+	group, err := client.Get(ctx, objectId)
+	if err != nil {
+		if utils.ResponseWasNotFound(group.Response) {
+			log.Printf("[DEBUG] Azure AD Group with Object ID %q was not found - removing from state!", objectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Azure AD Group with Object ID %q: %+v", objectId, err)
+	}
+
+	d.Set("name", group.DisplayName)
+	d.Set("object_id", group.ObjectID)
+
+	return nil
+}
+
+func resourceArmActiveDirectoryGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).groupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	objectId := d.Id()
+	resp, err := client.Delete(ctx, objectId)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Azure AD Group with Object ID %q: %+v", objectId, err)
+		}
+	}
+
+	return nil
+}
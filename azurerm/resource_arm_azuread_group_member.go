@@ -0,0 +1,160 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmActiveDirectoryGroupMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmActiveDirectoryGroupMemberCreate,
+		Read:   resourceArmActiveDirectoryGroupMemberRead,
+		Delete: resourceArmActiveDirectoryGroupMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			// a User, Group or Service Principal
+			"member_object_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func resourceArmActiveDirectoryGroupMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).groupsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	groupId := d.Get("group_object_id").(string)
+	memberId := d.Get("member_object_id").(string)
+
+	azureRMLockByName(groupId, azureADGroupResourceName)
+	defer azureRMUnlockByName(groupId, azureADGroupResourceName)
+
+	// members are referenced by the URL of the Directory Object within the tenant
+	properties := graphrbac.GroupAddMemberParameters{
+		URL: utils.String(fmt.Sprintf("%s/%s/directoryObjects/%s", strings.TrimSuffix(client.BaseURI, "/"), client.TenantID, memberId)),
+	}
+
+	if _, err := client.AddMember(ctx, groupId, properties); err != nil {
+		return fmt.Errorf("Error adding Member %q to Azure AD Group %q: %+v", memberId, groupId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/member/%s", groupId, memberId))
+
+	return resourceArmActiveDirectoryGroupMemberRead(d, meta)
+}
+
+func resourceArmActiveDirectoryGroupMemberRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).groupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureADGroupMemberId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	members, err := client.GetGroupMembersComplete(ctx, id.groupId)
+	if err != nil {
+		if utils.ResponseWasNotFound(members.Response().Response) {
+			log.Printf("[DEBUG] Azure AD Group with Object ID %q was not found - removing from state!", id.groupId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Members of Azure AD Group %q: %+v", id.groupId, err)
+	}
+
+	found := false
+	for members.NotDone() {
+		if objectId := members.Value().ObjectID; objectId != nil && strings.EqualFold(*objectId, id.memberId) {
+			found = true
+			break
+		}
+
+		if err := members.Next(); err != nil {
+			return fmt.Errorf("Error listing Members of Azure AD Group %q: %+v", id.groupId, err)
+		}
+	}
+
+	if !found {
+		log.Printf("[DEBUG] Member %q was not found in Azure AD Group %q - removing from state!", id.memberId, id.groupId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group_object_id", id.groupId)
+	d.Set("member_object_id", id.memberId)
+
+	return nil
+}
+
+func resourceArmActiveDirectoryGroupMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).groupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureADGroupMemberId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.groupId, azureADGroupResourceName)
+	defer azureRMUnlockByName(id.groupId, azureADGroupResourceName)
+
+	resp, err := client.RemoveMember(ctx, id.groupId, id.memberId)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error removing Member %q from Azure AD Group %q: %+v", id.memberId, id.groupId, err)
+		}
+	}
+
+	return nil
+}
+
+type azureADGroupMemberId struct {
+	groupId  string
+	memberId string
+}
+
+func parseAzureADGroupMemberId(input string) (*azureADGroupMemberId, error) {
+	// {groupObjectId}/member/{memberObjectId}
+	segments := strings.Split(input, "/")
+	if len(segments) != 3 || segments[1] != "member" || segments[0] == "" || segments[2] == "" {
+		return nil, fmt.Errorf("ID should be in the format {groupObjectId}/member/{memberObjectId} - but got %q", input)
+	}
+
+	id := azureADGroupMemberId{
+		groupId:  segments[0],
+		memberId: segments[2],
+	}
+	return &id, nil
+}
@@ -0,0 +1,211 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseAzureADGroupMemberId(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected *azureADGroupMemberId
+	}{
+		{
+			Input: "",
+		},
+		{
+			Input: "00000000-0000-0000-0000-000000000000",
+		},
+		{
+			Input: "00000000-0000-0000-0000-000000000000/11111111-1111-1111-1111-111111111111",
+		},
+		{
+			Input: "00000000-0000-0000-0000-000000000000/owner/11111111-1111-1111-1111-111111111111",
+		},
+		{
+			Input: "00000000-0000-0000-0000-000000000000/member/",
+		},
+		{
+			Input: "00000000-0000-0000-0000-000000000000/member/11111111-1111-1111-1111-111111111111",
+			Expected: &azureADGroupMemberId{
+				groupId:  "00000000-0000-0000-0000-000000000000",
+				memberId: "11111111-1111-1111-1111-111111111111",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := parseAzureADGroupMemberId(tc.Input)
+		if tc.Expected == nil {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but didn't get one", tc.Input)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error parsing %q but got: %+v", tc.Input, err)
+		}
+		if *actual != *tc.Expected {
+			t.Fatalf("Expected %+v for %q but got %+v", *tc.Expected, tc.Input, *actual)
+		}
+	}
+}
+
+func TestAccAzureRMActiveDirectoryGroupMember_servicePrincipal(t *testing.T) {
+	resourceName := "azurerm_azuread_group_member.test"
+	id := uuid.New().String()
+	config := testAccAzureRMActiveDirectoryGroupMember_servicePrincipal(id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMActiveDirectoryGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryGroupMemberExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "group_object_id", "azurerm_azuread_group.test", "object_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "member_object_id", "azurerm_azuread_service_principal.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMActiveDirectoryGroupMember_group(t *testing.T) {
+	resourceName := "azurerm_azuread_group_member.test"
+	id := uuid.New().String()
+	config := testAccAzureRMActiveDirectoryGroupMember_group(id)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMActiveDirectoryGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMActiveDirectoryGroupMemberExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "member_object_id", "azurerm_azuread_group.member", "object_id"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMActiveDirectoryGroupMemberExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		exists, err := testCheckAzureRMActiveDirectoryGroupMemberIsMember(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Bad: Azure AD Group Member %q does not exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMActiveDirectoryGroupMemberDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_azuread_group_member" {
+			continue
+		}
+
+		exists, err := testCheckAzureRMActiveDirectoryGroupMemberIsMember(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Azure AD Group Member %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return testCheckAzureRMActiveDirectoryGroupDestroy(s)
+}
+
+func testCheckAzureRMActiveDirectoryGroupMemberIsMember(input string) (bool, error) {
+	id, err := parseAzureADGroupMemberId(input)
+	if err != nil {
+		return false, err
+	}
+
+	client := testAccProvider.Meta().(*ArmClient).groupsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	members, err := client.GetGroupMembersComplete(ctx, id.groupId)
+	if err != nil {
+		if utils.ResponseWasNotFound(members.Response().Response) {
+			return false, nil
+		}
+		return false, fmt.Errorf("Bad: GetGroupMembers on Azure AD groupsClient: %+v", err)
+	}
+
+	for members.NotDone() {
+		if objectId := members.Value().ObjectID; objectId != nil && *objectId == id.memberId {
+			return true, nil
+		}
+
+		if err := members.Next(); err != nil {
+			return false, fmt.Errorf("Bad: listing Members of Azure AD Group %q: %+v", id.groupId, err)
+		}
+	}
+
+	return false, nil
+}
+
+func testAccAzureRMActiveDirectoryGroupMember_servicePrincipal(id string) string {
+	return fmt.Sprintf(`
+resource "azurerm_azuread_application" "test" {
+  name = "acctest%s"
+}
+
+resource "azurerm_azuread_service_principal" "test" {
+  application_id = "${azurerm_azuread_application.test.application_id}"
+}
+
+resource "azurerm_azuread_group" "test" {
+  name = "acctest%s"
+}
+
+resource "azurerm_azuread_group_member" "test" {
+  group_object_id  = "${azurerm_azuread_group.test.object_id}"
+  member_object_id = "${azurerm_azuread_service_principal.test.id}"
+}
+`, id, id)
+}
+
+func testAccAzureRMActiveDirectoryGroupMember_group(id string) string {
+	return fmt.Sprintf(`
+resource "azurerm_azuread_group" "test" {
+  name = "acctest%s"
+}
+
+resource "azurerm_azuread_group" "member" {
+  name = "acctest%s-member"
+}
+
+resource "azurerm_azuread_group_member" "test" {
+  group_object_id  = "${azurerm_azuread_group.test.object_id}"
+  member_object_id = "${azurerm_azuread_group.member.object_id}"
+}
+`, id, id)
+}
@@ -0,0 +1,309 @@
+package azurerm
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmActiveDirectoryServicePrincipalCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmActiveDirectoryServicePrincipalCertificateCreate,
+		Read:   resourceArmActiveDirectoryServicePrincipalCertificateRead,
+		Delete: resourceArmActiveDirectoryServicePrincipalCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service_principal_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"encoding": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "pem",
+				ValidateFunc: validation.StringInSlice([]string{
+					"base64",
+					"pem",
+				}, false),
+			},
+
+			"start_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RFC3339Time,
+			},
+
+			"end_date": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.RFC3339Time,
+			},
+		},
+	}
+}
+
+func resourceArmActiveDirectoryServicePrincipalCertificateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicePrincipalsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	objectId := d.Get("service_principal_id").(string)
+	value, err := expandAzureADServicePrincipalCertificateValue(d.Get("value").(string), d.Get("encoding").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing `value`: %+v", err)
+	}
+	// errors will be handled by the validation
+	endDate, _ := time.Parse(time.RFC3339, d.Get("end_date").(string))
+
+	var keyId string
+	if v, ok := d.GetOk("key_id"); ok {
+		keyId = v.(string)
+	} else {
+		kid, err := uuid.GenerateUUID()
+		if err != nil {
+			return err
+		}
+
+		keyId = kid
+	}
+
+	credential := graphrbac.KeyCredential{
+		KeyID:   utils.String(keyId),
+		Type:    utils.String("AsymmetricX509Cert"),
+		Usage:   utils.String("Verify"),
+		Value:   utils.String(value),
+		EndDate: &date.Time{Time: endDate},
+	}
+
+	if v, ok := d.GetOk("start_date"); ok {
+		// errors will be handled by the validation
+		startDate, _ := time.Parse(time.RFC3339, v.(string))
+		credential.StartDate = &date.Time{Time: startDate}
+	}
+
+	azureRMLockByName(objectId, servicePrincipalResourceName)
+	defer azureRMUnlockByName(objectId, servicePrincipalResourceName)
+
+	existingCredentials, err := client.ListKeyCredentials(ctx, objectId)
+	if err != nil {
+		return fmt.Errorf("Error Listing Key Credentials for Service Principal %q: %+v", objectId, err)
+	}
+
+	updatedCredentials := make([]graphrbac.KeyCredential, 0)
+	if existingCredentials.Value != nil {
+		updatedCredentials = *existingCredentials.Value
+	}
+
+	updatedCredentials = append(updatedCredentials, credential)
+
+	parameters := graphrbac.KeyCredentialsUpdateParameters{
+		Value: &updatedCredentials,
+	}
+	_, err = client.UpdateKeyCredentials(ctx, objectId, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating Certificate %q for Service Principal %q: %+v", keyId, objectId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", objectId, keyId))
+
+	return resourceArmActiveDirectoryServicePrincipalCertificateRead(d, meta)
+}
+
+func resourceArmActiveDirectoryServicePrincipalCertificateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicePrincipalsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id := strings.Split(d.Id(), "/")
+	if len(id) != 2 {
+		return fmt.Errorf("ID should be in the format {objectId}/{keyId} - but got %q", d.Id())
+	}
+
+	objectId := id[0]
+	keyId := id[1]
+
+	// ensure the parent Service Principal exists
+	servicePrincipal, err := client.Get(ctx, objectId)
+	if err != nil {
+		// the parent Service Principal has been removed - skip it
+		if utils.ResponseWasNotFound(servicePrincipal.Response) {
+			log.Printf("[DEBUG] Service Principal with Object ID %q was not found - removing from state!", objectId)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, err)
+	}
+
+	credentials, err := client.ListKeyCredentials(ctx, objectId)
+	if err != nil {
+		return fmt.Errorf("Error Listing Key Credentials for Service Principal with Object ID %q: %+v", objectId, err)
+	}
+
+	var credential *graphrbac.KeyCredential
+	if credentials.Value != nil {
+		for _, c := range *credentials.Value {
+			if c.KeyID == nil {
+				continue
+			}
+
+			if *c.KeyID == keyId {
+				credential = &c
+				break
+			}
+		}
+	}
+
+	if credential == nil {
+		log.Printf("[DEBUG] Service Principal Certificate %q (Object ID %q) was not found - removing from state!", keyId, objectId)
+		d.SetId("")
+		return nil
+	}
+
+	// value isn't returned from the API
+	d.Set("key_id", credential.KeyID)
+	d.Set("service_principal_id", objectId)
+
+	if endDate := credential.EndDate; endDate != nil {
+		d.Set("end_date", endDate.Format(time.RFC3339))
+	}
+
+	if startDate := credential.StartDate; startDate != nil {
+		d.Set("start_date", startDate.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceArmActiveDirectoryServicePrincipalCertificateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicePrincipalsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id := strings.Split(d.Id(), "/")
+	if len(id) != 2 {
+		return fmt.Errorf("ID should be in the format {objectId}/{keyId} - but got %q", d.Id())
+	}
+
+	objectId := id[0]
+	keyId := id[1]
+
+	azureRMLockByName(objectId, servicePrincipalResourceName)
+	defer azureRMUnlockByName(objectId, servicePrincipalResourceName)
+
+	// ensure the parent Service Principal exists
+	servicePrincipal, err := client.Get(ctx, objectId)
+	if err != nil {
+		// the parent Service Principal was removed - skip it
+		if utils.ResponseWasNotFound(servicePrincipal.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Service Principal ID %q: %+v", objectId, err)
+	}
+
+	existing, err := client.ListKeyCredentials(ctx, objectId)
+	if err != nil {
+		return fmt.Errorf("Error Listing Key Credentials for Service Principal with Object ID %q: %+v", objectId, err)
+	}
+
+	updatedCredentials := make([]graphrbac.KeyCredential, 0)
+	if existing.Value != nil {
+		for _, credential := range *existing.Value {
+			if credential.KeyID == nil {
+				continue
+			}
+
+			if *credential.KeyID != keyId {
+				updatedCredentials = append(updatedCredentials, credential)
+			}
+		}
+	}
+
+	parameters := graphrbac.KeyCredentialsUpdateParameters{
+		Value: &updatedCredentials,
+	}
+	_, err = client.UpdateKeyCredentials(ctx, objectId, parameters)
+	if err != nil {
+		return fmt.Errorf("Error removing Certificate %q from Service Principal %q: %+v", keyId, objectId, err)
+	}
+
+	return nil
+}
+
+// expandAzureADServicePrincipalCertificateValue returns the Base64 encoded DER form of the Certificate expected by
+// the API, from either a PEM encoded Certificate or a Base64 encoded DER Certificate
+func expandAzureADServicePrincipalCertificateValue(value string, encoding string) (string, error) {
+	var der []byte
+
+	switch encoding {
+	case "pem":
+		block, _ := pem.Decode([]byte(value))
+		if block == nil {
+			return "", fmt.Errorf("no PEM data was found")
+		}
+		if block.Type != "CERTIFICATE" {
+			return "", fmt.Errorf("expected a PEM block of type %q but got %q", "CERTIFICATE", block.Type)
+		}
+		der = block.Bytes
+
+	case "base64":
+		decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+		if err != nil {
+			return "", fmt.Errorf("value isn't valid Base64: %+v", err)
+		}
+		der = decoded
+
+	default:
+		return "", fmt.Errorf("unsupported encoding %q", encoding)
+	}
+
+	if _, err := x509.ParseCertificate(der); err != nil {
+		return "", fmt.Errorf("value isn't a valid X.509 Certificate: %+v", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(der), nil
+}
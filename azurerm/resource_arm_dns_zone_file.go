@@ -0,0 +1,560 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/zonefile"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// dnsZoneFileRecordTypes are the Record Types which can be managed using a Zone File - the SOA Record and the NS
+// Records at the apex of the Zone are managed by Azure DNS and are ignored
+var dnsZoneFileRecordTypes = []dns.RecordType{
+	dns.A,
+	dns.AAAA,
+	dns.CAA,
+	dns.CNAME,
+	dns.MX,
+	dns.NS,
+	dns.PTR,
+	dns.SRV,
+	dns.TXT,
+}
+
+func resourceArmDnsZoneFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsZoneFileCreateUpdate,
+		Read:   resourceArmDnsZoneFileRead,
+		Update: resourceArmDnsZoneFileCreateUpdate,
+		Delete: resourceArmDnsZoneFileDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceArmDnsZoneFileCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"zone_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"content": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"authoritative": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// a map of `{name}/{type}` to the TTL and Records in each Record Set, such that the
+			// plan shows which Record Sets are being created, updated and deleted
+			"record_sets": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// resourceArmDnsZoneFileCustomizeDiff parses the Zone File during the plan, so that the changes to each
+// of the Record Sets are shown in the plan via the `record_sets` attribute
+func resourceArmDnsZoneFileCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	zoneName, zoneNameOk := d.GetOk("zone_name")
+	content, contentOk := d.GetOk("content")
+	if !zoneNameOk || !contentOk {
+		log.Printf("[DEBUG] Unable to parse the Zone File since the `zone_name` or `content` aren't known yet")
+		return d.SetNewComputed("record_sets")
+	}
+
+	recordSets, err := parseDnsZoneFile(content.(string), zoneName.(string))
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("record_sets", flattenDnsZoneFileRecordSets(recordSets))
+}
+
+func resourceArmDnsZoneFileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dnsClient
+	zonesClient := meta.(*ArmClient).zonesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	zoneName := d.Get("zone_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	authoritative := d.Get("authoritative").(bool)
+
+	zone, err := zonesClient.Get(ctx, resGroup, zoneName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving DNS Zone %q (Resource Group %q): %+v", zoneName, resGroup, err)
+	}
+
+	if zone.ID == nil {
+		return fmt.Errorf("Cannot read DNS Zone %q (Resource Group %q) ID", zoneName, resGroup)
+	}
+
+	desired, err := parseDnsZoneFile(d.Get("content").(string), zoneName)
+	if err != nil {
+		return err
+	}
+
+	// in authoritative mode every Record Set within the Zone which isn't in the Zone File is deleted, otherwise
+	// only the Record Sets which were previously in the Zone File (and have since been removed) are deleted
+	existing := make(map[string]interface{})
+	if authoritative {
+		recordSets, err := listDnsZoneFileRecordSets(ctx, client, resGroup, zoneName)
+		if err != nil {
+			return err
+		}
+		existing = flattenDnsZoneFileRecordSets(recordSets)
+	} else if d.Id() != "" && !d.HasChange("authoritative") {
+		// when switching out of authoritative mode the state contains every Record Set within the Zone,
+		// rather than those which were in the Zone File - so we can't tell which should be deleted
+		old, _ := d.GetChange("record_sets")
+		existing = old.(map[string]interface{})
+	}
+
+	desiredValues := flattenDnsZoneFileRecordSets(desired)
+	for key := range existing {
+		if _, ok := desiredValues[key]; ok {
+			continue
+		}
+
+		if err := deleteDnsZoneFileRecordSet(ctx, client, resGroup, zoneName, key); err != nil {
+			return err
+		}
+	}
+
+	for _, recordSet := range desired {
+		key := dnsZoneFileRecordSetKey(recordSet)
+		if v, ok := existing[key]; ok && v.(string) == desiredValues[key] {
+			continue
+		}
+
+		log.Printf("[DEBUG] Creating/updating the %s Record Set %q within DNS Zone %q (Resource Group %q)", recordSet.Type, recordSet.Name, zoneName, resGroup)
+		parameters, err := expandDnsZoneFileRecordSet(recordSet)
+		if err != nil {
+			return err
+		}
+
+		eTag := ""
+		ifNoneMatch := "" // set to empty to allow updates to records after creation
+		if _, err := client.CreateOrUpdate(ctx, resGroup, zoneName, recordSet.Name, dns.RecordType(recordSet.Type), parameters, eTag, ifNoneMatch); err != nil {
+			return fmt.Errorf("Error creating/updating the %s Record Set %q within DNS Zone %q (Resource Group %q): %+v", recordSet.Type, recordSet.Name, zoneName, resGroup, err)
+		}
+	}
+
+	d.SetId(*zone.ID)
+	d.Set("record_sets", desiredValues)
+
+	return resourceArmDnsZoneFileRead(d, meta)
+}
+
+func resourceArmDnsZoneFileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dnsClient
+	zonesClient := meta.(*ArmClient).zonesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	zoneName := id.Path["dnszones"]
+
+	resp, err := zonesClient.Get(ctx, resGroup, zoneName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] DNS Zone %q (Resource Group %q) was not found - removing from state", zoneName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving DNS Zone %q (Resource Group %q): %+v", zoneName, resGroup, err)
+	}
+
+	recordSets, err := listDnsZoneFileRecordSets(ctx, client, resGroup, zoneName)
+	if err != nil {
+		return err
+	}
+
+	// when not in authoritative mode only the Record Sets which are in the Zone File are tracked, such that
+	// any other Record Sets within the Zone don't show as a diff
+	values := flattenDnsZoneFileRecordSets(recordSets)
+	if !d.Get("authoritative").(bool) {
+		managed := d.Get("record_sets").(map[string]interface{})
+		for key := range values {
+			if _, ok := managed[key]; !ok {
+				delete(values, key)
+			}
+		}
+	}
+
+	d.Set("zone_name", zoneName)
+	d.Set("resource_group_name", resGroup)
+	if err := d.Set("record_sets", values); err != nil {
+		return fmt.Errorf("Error setting `record_sets`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmDnsZoneFileDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dnsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	zoneName := id.Path["dnszones"]
+
+	for key := range d.Get("record_sets").(map[string]interface{}) {
+		if err := deleteDnsZoneFileRecordSet(ctx, client, resGroup, zoneName, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseDnsZoneFile parses the Zone File, returning the Record Sets which can be managed in Azure DNS
+func parseDnsZoneFile(content, zoneName string) ([]zonefile.RecordSet, error) {
+	recordSets, err := zonefile.Parse(content, zoneName)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the Zone File for DNS Zone %q: %+v", zoneName, err)
+	}
+
+	output := make([]zonefile.RecordSet, 0)
+	for _, v := range recordSets {
+		if !isDnsZoneFileRecordSetManaged(v.Name, v.Type) {
+			log.Printf("[DEBUG] Ignoring the %s Record Set %q in the Zone File for DNS Zone %q since it's managed by Azure DNS", v.Type, v.Name, zoneName)
+			continue
+		}
+
+		output = append(output, v)
+	}
+
+	return output, nil
+}
+
+func listDnsZoneFileRecordSets(ctx context.Context, client dns.RecordSetsClient, resGroup, zoneName string) ([]zonefile.RecordSet, error) {
+	output := make([]zonefile.RecordSet, 0)
+
+	for _, recordType := range dnsZoneFileRecordTypes {
+		resp, err := client.ListByTypeComplete(ctx, resGroup, zoneName, recordType, nil, "")
+		if err != nil {
+			return nil, fmt.Errorf("Error listing the %s Record Sets within DNS Zone %q (Resource Group %q): %+v", recordType, zoneName, resGroup, err)
+		}
+
+		for resp.NotDone() {
+			recordSet := flattenDnsZoneFileRecordSet(recordType, resp.Value())
+			if isDnsZoneFileRecordSetManaged(recordSet.Name, recordSet.Type) {
+				output = append(output, recordSet)
+			}
+
+			if err := resp.Next(); err != nil {
+				return nil, fmt.Errorf("Error listing the %s Record Sets within DNS Zone %q (Resource Group %q): %+v", recordType, zoneName, resGroup, err)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func deleteDnsZoneFileRecordSet(ctx context.Context, client dns.RecordSetsClient, resGroup, zoneName, key string) error {
+	segments := strings.Split(key, "/")
+	if len(segments) != 2 {
+		return fmt.Errorf("Expected the Record Set %q to be in the format `{name}/{type}`", key)
+	}
+	name, recordType := segments[0], segments[1]
+
+	log.Printf("[DEBUG] Deleting the %s Record Set %q within DNS Zone %q (Resource Group %q)", recordType, name, zoneName, resGroup)
+	if _, err := client.Delete(ctx, resGroup, zoneName, name, dns.RecordType(recordType), ""); err != nil {
+		return fmt.Errorf("Error deleting the %s Record Set %q within DNS Zone %q (Resource Group %q): %+v", recordType, name, zoneName, resGroup, err)
+	}
+
+	return nil
+}
+
+func isDnsZoneFileRecordSetManaged(name, recordType string) bool {
+	if recordType == string(dns.SOA) {
+		return false
+	}
+
+	return !(recordType == string(dns.NS) && name == "@")
+}
+
+func dnsZoneFileRecordSetKey(recordSet zonefile.RecordSet) string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(recordSet.Name), recordSet.Type)
+}
+
+// dnsZoneFileRecordSetValue returns the TTL followed by the (sorted) Records within the Record Set in the
+// Zone File format, e.g. `3600 10 mail1.example.com; 20 mail2.example.com`
+func dnsZoneFileRecordSetValue(recordSet zonefile.RecordSet) string {
+	records := make([]string, 0, len(recordSet.Records))
+	for _, record := range recordSet.Records {
+		fields := make([]string, len(record))
+		for i, v := range record {
+			// TXT Records consist of quoted strings, as does the value of a CAA Record
+			if recordSet.Type == string(dns.TXT) || (recordSet.Type == string(dns.CAA) && i == 2) {
+				v = strconv.Quote(v)
+			}
+			fields[i] = v
+		}
+		records = append(records, strings.Join(fields, " "))
+	}
+	sort.Strings(records)
+
+	return fmt.Sprintf("%d %s", recordSet.TTL, strings.Join(records, "; "))
+}
+
+func flattenDnsZoneFileRecordSets(recordSets []zonefile.RecordSet) map[string]interface{} {
+	output := make(map[string]interface{})
+	for _, v := range recordSets {
+		output[dnsZoneFileRecordSetKey(v)] = dnsZoneFileRecordSetValue(v)
+	}
+
+	return output
+}
+
+func expandDnsZoneFileRecordSet(recordSet zonefile.RecordSet) (dns.RecordSet, error) {
+	properties := dns.RecordSetProperties{
+		TTL: utils.Int64(recordSet.TTL),
+	}
+
+	// the fields of each Record have been validated when parsing the Zone File
+	atoi := func(v string) *int32 {
+		i, _ := strconv.Atoi(v)
+		return utils.Int32(int32(i))
+	}
+
+	switch dns.RecordType(recordSet.Type) {
+	case dns.A:
+		records := make([]dns.ARecord, 0)
+		for _, v := range recordSet.Records {
+			records = append(records, dns.ARecord{Ipv4Address: utils.String(v[0])})
+		}
+		properties.ARecords = &records
+
+	case dns.AAAA:
+		records := make([]dns.AaaaRecord, 0)
+		for _, v := range recordSet.Records {
+			records = append(records, dns.AaaaRecord{Ipv6Address: utils.String(v[0])})
+		}
+		properties.AaaaRecords = &records
+
+	case dns.CAA:
+		records := make([]dns.CaaRecord, 0)
+		for _, v := range recordSet.Records {
+			records = append(records, dns.CaaRecord{
+				Flags: atoi(v[0]),
+				Tag:   utils.String(v[1]),
+				Value: utils.String(v[2]),
+			})
+		}
+		properties.CaaRecords = &records
+
+	case dns.CNAME:
+		properties.CnameRecord = &dns.CnameRecord{
+			Cname: utils.String(recordSet.Records[0][0]),
+		}
+
+	case dns.MX:
+		records := make([]dns.MxRecord, 0)
+		for _, v := range recordSet.Records {
+			records = append(records, dns.MxRecord{
+				Preference: atoi(v[0]),
+				Exchange:   utils.String(v[1]),
+			})
+		}
+		properties.MxRecords = &records
+
+	case dns.NS:
+		records := make([]dns.NsRecord, 0)
+		for _, v := range recordSet.Records {
+			records = append(records, dns.NsRecord{Nsdname: utils.String(v[0])})
+		}
+		properties.NsRecords = &records
+
+	case dns.PTR:
+		records := make([]dns.PtrRecord, 0)
+		for _, v := range recordSet.Records {
+			records = append(records, dns.PtrRecord{Ptrdname: utils.String(v[0])})
+		}
+		properties.PtrRecords = &records
+
+	case dns.SRV:
+		records := make([]dns.SrvRecord, 0)
+		for _, v := range recordSet.Records {
+			records = append(records, dns.SrvRecord{
+				Priority: atoi(v[0]),
+				Weight:   atoi(v[1]),
+				Port:     atoi(v[2]),
+				Target:   utils.String(v[3]),
+			})
+		}
+		properties.SrvRecords = &records
+
+	case dns.TXT:
+		records := make([]dns.TxtRecord, 0)
+		for _, v := range recordSet.Records {
+			value := v
+			records = append(records, dns.TxtRecord{Value: &value})
+		}
+		properties.TxtRecords = &records
+
+	default:
+		return dns.RecordSet{}, fmt.Errorf("The %s Record Set %q is not supported", recordSet.Type, recordSet.Name)
+	}
+
+	return dns.RecordSet{
+		Name:                utils.String(recordSet.Name),
+		RecordSetProperties: &properties,
+	}, nil
+}
+
+// flattenDnsZoneFileRecordSet converts a Record Set returned from the API into the format used by the Zone File
+// parser, such that the Record Sets in the Zone File can be compared to those which exist
+func flattenDnsZoneFileRecordSet(recordType dns.RecordType, input dns.RecordSet) zonefile.RecordSet {
+	output := zonefile.RecordSet{
+		Type:    string(recordType),
+		Records: make([][]string, 0),
+	}
+
+	if input.Name != nil {
+		output.Name = strings.ToLower(*input.Name)
+	}
+
+	props := input.RecordSetProperties
+	if props == nil {
+		return output
+	}
+
+	if props.TTL != nil {
+		output.TTL = *props.TTL
+	}
+
+	str := func(v *string) string {
+		if v == nil {
+			return ""
+		}
+		return *v
+	}
+	num := func(v *int32) string {
+		if v == nil {
+			return "0"
+		}
+		return strconv.Itoa(int(*v))
+	}
+	domainName := func(v *string) string {
+		return strings.TrimSuffix(str(v), ".")
+	}
+	ipAddress := func(v *string) string {
+		if ip := net.ParseIP(str(v)); ip != nil {
+			return ip.String()
+		}
+		return str(v)
+	}
+
+	switch recordType {
+	case dns.A:
+		if props.ARecords != nil {
+			for _, v := range *props.ARecords {
+				output.Records = append(output.Records, []string{ipAddress(v.Ipv4Address)})
+			}
+		}
+
+	case dns.AAAA:
+		if props.AaaaRecords != nil {
+			for _, v := range *props.AaaaRecords {
+				output.Records = append(output.Records, []string{ipAddress(v.Ipv6Address)})
+			}
+		}
+
+	case dns.CAA:
+		if props.CaaRecords != nil {
+			for _, v := range *props.CaaRecords {
+				output.Records = append(output.Records, []string{num(v.Flags), str(v.Tag), str(v.Value)})
+			}
+		}
+
+	case dns.CNAME:
+		if props.CnameRecord != nil {
+			output.Records = append(output.Records, []string{domainName(props.CnameRecord.Cname)})
+		}
+
+	case dns.MX:
+		if props.MxRecords != nil {
+			for _, v := range *props.MxRecords {
+				output.Records = append(output.Records, []string{num(v.Preference), domainName(v.Exchange)})
+			}
+		}
+
+	case dns.NS:
+		if props.NsRecords != nil {
+			for _, v := range *props.NsRecords {
+				output.Records = append(output.Records, []string{domainName(v.Nsdname)})
+			}
+		}
+
+	case dns.PTR:
+		if props.PtrRecords != nil {
+			for _, v := range *props.PtrRecords {
+				output.Records = append(output.Records, []string{domainName(v.Ptrdname)})
+			}
+		}
+
+	case dns.SRV:
+		if props.SrvRecords != nil {
+			for _, v := range *props.SrvRecords {
+				output.Records = append(output.Records, []string{num(v.Priority), num(v.Weight), num(v.Port), domainName(v.Target)})
+			}
+		}
+
+	case dns.TXT:
+		if props.TxtRecords != nil {
+			for _, v := range *props.TxtRecords {
+				if v.Value != nil {
+					output.Records = append(output.Records, *v.Value)
+				}
+			}
+		}
+	}
+
+	return output
+}
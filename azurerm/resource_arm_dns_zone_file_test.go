@@ -0,0 +1,230 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestUnitAzureRMDnsZoneFile_authoritative(t *testing.T) {
+	resourceName := "azurerm_dns_zone_file.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	// a Record Set which exists within the Zone but isn't in the Zone File
+	unmanagedRecordSetId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/dnszones/acctestzone%d.com/A/legacy", fakearm.SubscriptionID, ri, ri)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					server.Put(unmanagedRecordSetId, map[string]interface{}{
+						"properties": map[string]interface{}{
+							"TTL": 300,
+							"ARecords": []interface{}{
+								map[string]interface{}{"ipv4Address": "10.1.1.1"},
+							},
+						},
+					})
+				},
+				Config: testAccAzureRMDnsZoneFile_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record_sets.%", "5"),
+					resource.TestCheckResourceAttr(resourceName, "record_sets.www/A", "3600 10.0.0.1; 10.0.0.2"),
+					resource.TestCheckResourceAttr(resourceName, "record_sets.@/MX", fmt.Sprintf("3600 10 mail.acctestzone%d.com", ri)),
+					resource.TestCheckResourceAttr(resourceName, "record_sets.@/TXT", `3600 "v=spf1 mx ~all"`),
+					resource.TestCheckResourceAttr(resourceName, "record_sets.ftp/CNAME", fmt.Sprintf("300 www.acctestzone%d.com", ri)),
+					testCheckFakeDnsZoneFileRecordSet(server, resourceName, dns.CNAME, "ftp", true),
+					testCheckFakeDnsZoneFileRecordSet(server, resourceName, dns.A, "legacy", true),
+				),
+			},
+			{
+				Config: testAccAzureRMDnsZoneFile_authoritative(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record_sets.%", "5"),
+					resource.TestCheckResourceAttr(resourceName, "record_sets.www/A", "3600 10.0.0.4"),
+					resource.TestCheckResourceAttr(resourceName, "record_sets._sip._tcp/SRV", fmt.Sprintf("600 10 60 5060 sip.acctestzone%d.com", ri)),
+					testCheckFakeDnsZoneFileRecordSet(server, resourceName, dns.SRV, "_sip._tcp", true),
+					testCheckFakeDnsZoneFileRecordSet(server, resourceName, dns.CNAME, "ftp", false),
+					testCheckFakeDnsZoneFileRecordSet(server, resourceName, dns.A, "legacy", false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsZoneFile_basic(t *testing.T) {
+	resourceName := "azurerm_dns_zone_file.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsZoneFile_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record_sets.%", "5"),
+					testCheckAzureRMDnsZoneFileRecordSet(resourceName, dns.A, "www", true),
+					testCheckAzureRMDnsZoneFileRecordSet(resourceName, dns.CNAME, "ftp", true),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the Zone File can't be imported, and the Record Sets it manages are adopted on the next apply
+				ImportStateVerifyIgnore: []string{"content", "record_sets"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMDnsZoneFile_authoritative(t *testing.T) {
+	resourceName := "azurerm_dns_zone_file.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsZoneFile_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsZoneFileRecordSet(resourceName, dns.CNAME, "ftp", true),
+				),
+			},
+			{
+				Config: testAccAzureRMDnsZoneFile_authoritative(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "record_sets.%", "5"),
+					resource.TestCheckResourceAttr(resourceName, "record_sets.www/A", "3600 10.0.0.4"),
+					testCheckAzureRMDnsZoneFileRecordSet(resourceName, dns.SRV, "_sip._tcp", true),
+					testCheckAzureRMDnsZoneFileRecordSet(resourceName, dns.CNAME, "ftp", false),
+				),
+			},
+		},
+	})
+}
+
+func testCheckFakeDnsZoneFileRecordSet(server *fakearm.Server, resourceName string, recordType dns.RecordType, name string, shouldExist bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id := fmt.Sprintf("%s/%s/%s", rs.Primary.ID, recordType, name)
+		if _, exists := server.Get(id); exists != shouldExist {
+			return fmt.Errorf("Bad: expected the %s Record Set %q to exist (%t) in the fake Resource Manager API but got %t", recordType, name, shouldExist, exists)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDnsZoneFileRecordSet(resourceName string, recordType dns.RecordType, name string, shouldExist bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).dnsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, zoneName, name, recordType)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				if shouldExist {
+					return fmt.Errorf("Bad: %s Record Set %q (Zone %q / Resource Group %q) does not exist", recordType, name, zoneName, resourceGroup)
+				}
+
+				return nil
+			}
+
+			return fmt.Errorf("Bad: Get on dnsClient: %+v", err)
+		}
+
+		if !shouldExist {
+			return fmt.Errorf("Bad: %s Record Set %q (Zone %q / Resource Group %q) still exists", recordType, name, zoneName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMDnsZoneFile_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dns_zone_file" "test" {
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  content = <<ZONE
+$ORIGIN acctestzone%d.com.
+$TTL 1h
+@     IN  MX    10 mail
+@     IN  TXT   "v=spf1 mx ~all"
+www   IN  A     10.0.0.1
+      IN  A     10.0.0.2
+mail  IN  A     10.0.0.3
+ftp   300 IN    CNAME www
+ZONE
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMDnsZoneFile_authoritative(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dns_zone_file" "test" {
+  zone_name           = "${azurerm_dns_zone.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  authoritative       = true
+
+  content = <<ZONE
+$ORIGIN acctestzone%d.com.
+$TTL 1h
+@          IN  MX    10 mail
+@          IN  TXT   "v=spf1 mx ~all"
+www        IN  A     10.0.0.4
+mail       IN  A     10.0.0.3
+_sip._tcp  600 IN    SRV 10 60 5060 sip
+ZONE
+}
+`, rInt, location, rInt, rInt)
+}
@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,6 +26,13 @@ func resourceArmFirewall() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -72,14 +81,16 @@ func resourceArmFirewall() *schema.Resource {
 
 func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ruleCollectionsClient := meta.(*ArmClient).firewallRuleCollectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Azure Firewall creation")
 
 	name := d.Get("name").(string)
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	resourceGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags := mergeDefaultTags(meta, d.Get("tags").(map[string]interface{}))
 	ipConfigs, subnetToLock, vnetToLock, err := expandArmFirewallIPConfigurations(d)
 	if err != nil {
 		return fmt.Errorf("Error Building list of Azure Firewall IP Configurations: %+v", err)
@@ -94,20 +105,35 @@ func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockMultipleByName(vnetToLock, virtualNetworkResourceName)
 	defer azureRMUnlockMultipleByName(vnetToLock, virtualNetworkResourceName)
 
-	parameters := network.AzureFirewall{
+	parameters := azureFirewallWithRuleCollections{
 		Location: &location,
 		Tags:     expandTags(tags),
-		AzureFirewallPropertiesFormat: &network.AzureFirewallPropertiesFormat{
-			IPConfigurations: ipConfigs,
-		},
+	}
+	if err := parameters.marshalProperty("ipConfigurations", ipConfigs); err != nil {
+		return fmt.Errorf("Error setting IP Configurations for Azure Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// the Rule Collections are managed using their own resources - but are a part of the Firewall, so the existing
+	// Rule Collections need to be sent back when updating the Firewall, otherwise they'd be removed
+	if !d.IsNewResource() {
+		existing, err := ruleCollectionsClient.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Azure Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		for _, key := range []string{"applicationRuleCollections", "natRuleCollections", "networkRuleCollections"} {
+			if v, ok := existing.Properties[key]; ok {
+				parameters.Properties[key] = v
+			}
+		}
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	future, err := ruleCollectionsClient.CreateOrUpdate(ctx, resourceGroup, name, parameters)
 	if err != nil {
 		return fmt.Errorf("Error creating/updating Azure Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = future.WaitForCompletionRef(ctx, ruleCollectionsClient.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for creation/update of Azure Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -128,7 +154,8 @@ func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmFirewallRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -161,14 +188,15 @@ func resourceArmFirewallRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	flattenAndSetTags(d, read.Tags)
+	flattenAndSetResourceTags(d, meta, read.Tags)
 
 	return nil
 }
 
 func resourceArmFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -271,7 +299,9 @@ func expandArmFirewallIPConfigurations(d *schema.ResourceData) (*[]network.Azure
 				Subnet: &network.SubResource{
 					ID: utils.String(subnetId),
 				},
-				InternalPublicIPAddress: &network.SubResource{
+				// the Firewall is created/updated using version 2019-04-01 of the Network API, which (unlike the
+				// version of the Network SDK in use) accepts the Public IP Address as `publicIPAddress`
+				PublicIPAddress: &network.SubResource{
 					ID: utils.String(intPubID),
 				},
 			},
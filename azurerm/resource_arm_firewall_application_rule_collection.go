@@ -0,0 +1,465 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmFirewallApplicationRuleCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFirewallApplicationRuleCollectionCreateUpdate,
+		Read:   resourceArmFirewallApplicationRuleCollectionRead,
+		Update: resourceArmFirewallApplicationRuleCollectionCreateUpdate,
+		Delete: resourceArmFirewallApplicationRuleCollectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureFirewallName,
+			},
+
+			"azure_firewall_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureFirewallName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 65000),
+			},
+
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.AzureFirewallRCActionTypeAllow),
+					string(network.AzureFirewallRCActionTypeDeny),
+				}, false),
+			},
+
+			"rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_addresses": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"fqdn_tags": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"target_fqdns": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"protocol": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Http",
+											"Https",
+											"Mssql",
+										}, false),
+									},
+									"port": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(0, 64000),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmFirewallApplicationRuleCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).firewallRuleCollectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	firewallName := d.Get("azure_firewall_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	applicationRules, err := expandArmFirewallApplicationRules(d.Get("rule").(*schema.Set))
+	if err != nil {
+		return fmt.Errorf("Error expanding Application Rules for Application Rule Collection %q (Firewall %q / Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	azureRMLockByName(firewallName, azureFirewallResourceName)
+	defer azureRMUnlockByName(firewallName, azureFirewallResourceName)
+
+	firewall, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	if firewall.Properties == nil {
+		return fmt.Errorf("Error expanding Firewall %q (Resource Group %q): `properties` was nil.", firewallName, resourceGroup)
+	}
+
+	ruleCollections, err := firewall.ApplicationRuleCollections()
+	if err != nil {
+		return fmt.Errorf("Error expanding Firewall %q (Resource Group %q): `properties.applicationRuleCollections` was invalid: %+v", firewallName, resourceGroup, err)
+	}
+
+	priority := d.Get("priority").(int)
+	newRuleCollection := azureFirewallApplicationRuleCollection{
+		Name: utils.String(name),
+		Properties: &azureFirewallApplicationRuleCollectionProperties{
+			Action: &azureFirewallRCAction{
+				Type: d.Get("action").(string),
+			},
+			Priority: utils.Int32(int32(priority)),
+			Rules:    &applicationRules,
+		},
+	}
+
+	if !d.IsNewResource() {
+		index := -1
+		for i, v := range ruleCollections {
+			if v.Name == nil {
+				continue
+			}
+
+			if *v.Name == name {
+				index = i
+				break
+			}
+		}
+
+		if index == -1 {
+			return fmt.Errorf("Error locating Application Rule Collection %q (Firewall %q / Resource Group %q)", name, firewallName, resourceGroup)
+		}
+
+		ruleCollections[index] = newRuleCollection
+	} else {
+		ruleCollections = append(ruleCollections, newRuleCollection)
+	}
+
+	if err := firewall.SetApplicationRuleCollections(ruleCollections); err != nil {
+		return fmt.Errorf("Error setting Application Rule Collections for Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, firewallName, firewall)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Application Rule Collection %q in Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletionRef(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Application Rule Collection %q of Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	collections, err := read.ApplicationRuleCollections()
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Rule Collections for Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	var collectionID string
+	for _, collection := range collections {
+		if collection.Name == nil || collection.ID == nil {
+			continue
+		}
+
+		if *collection.Name == name {
+			collectionID = *collection.ID
+			break
+		}
+	}
+
+	if collectionID == "" {
+		return fmt.Errorf("Cannot find ID for Application Rule Collection %q (Azure Firewall %q / Resource Group %q)", name, firewallName, resourceGroup)
+	}
+	d.SetId(collectionID)
+
+	return resourceArmFirewallApplicationRuleCollectionRead(d, meta)
+}
+
+func resourceArmFirewallApplicationRuleCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).firewallRuleCollectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	firewallName := id.Path["azureFirewalls"]
+	name := id.Path["applicationRuleCollections"]
+
+	read, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] Azure Firewall %q (Resource Group %q) was not found - removing from state!", firewallName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Azure Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	collections, err := read.ApplicationRuleCollections()
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Rule Collection %q (Firewall %q / Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	var rule *azureFirewallApplicationRuleCollection
+	for _, r := range collections {
+		if r.Name == nil {
+			continue
+		}
+
+		if *r.Name == name {
+			rule = &r
+			break
+		}
+	}
+
+	if rule == nil {
+		log.Printf("[DEBUG] Application Rule Collection %q was not found on Firewall %q (Resource Group %q) - removing from state!", name, firewallName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("azure_firewall_name", firewallName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := rule.Properties; props != nil {
+		if action := props.Action; action != nil {
+			d.Set("action", action.Type)
+		}
+
+		if priority := props.Priority; priority != nil {
+			d.Set("priority", int(*priority))
+		}
+
+		flattenedRules := flattenFirewallApplicationRuleCollectionRules(props.Rules)
+		if err := d.Set("rule", flattenedRules); err != nil {
+			return fmt.Errorf("Error setting `rule`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmFirewallApplicationRuleCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).firewallRuleCollectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	firewallName := id.Path["azureFirewalls"]
+	name := id.Path["applicationRuleCollections"]
+
+	azureRMLockByName(firewallName, azureFirewallResourceName)
+	defer azureRMUnlockByName(firewallName, azureFirewallResourceName)
+
+	firewall, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		if utils.ResponseWasNotFound(firewall.Response) {
+			// assume deleted
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Azure Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	collections, err := firewall.ApplicationRuleCollections()
+	if err != nil {
+		return fmt.Errorf("Error retrieving Application Rule Collection %q (Firewall %q / Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	applicationRules := make([]azureFirewallApplicationRuleCollection, 0)
+	for _, rule := range collections {
+		if rule.Name == nil {
+			continue
+		}
+
+		if *rule.Name != name {
+			applicationRules = append(applicationRules, rule)
+		}
+	}
+
+	if err := firewall.SetApplicationRuleCollections(applicationRules); err != nil {
+		return fmt.Errorf("Error setting Application Rule Collections for Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, firewallName, firewall)
+	if err != nil {
+		return fmt.Errorf("Error deleting Application Rule Collection %q from Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletionRef(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for deletion of Application Rule Collection %q from Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmFirewallApplicationRules(input *schema.Set) ([]azureFirewallApplicationRule, error) {
+	appRules := input.List()
+	rules := make([]azureFirewallApplicationRule, 0)
+
+	for _, appRule := range appRules {
+		rule := appRule.(map[string]interface{})
+
+		name := rule["name"].(string)
+		description := rule["description"].(string)
+
+		sourceAddresses := make([]string, 0)
+		for _, v := range rule["source_addresses"].(*schema.Set).List() {
+			sourceAddresses = append(sourceAddresses, v.(string))
+		}
+
+		fqdnTags := make([]string, 0)
+		for _, v := range rule["fqdn_tags"].(*schema.Set).List() {
+			fqdnTags = append(fqdnTags, v.(string))
+		}
+
+		targetFqdns := make([]string, 0)
+		for _, v := range rule["target_fqdns"].(*schema.Set).List() {
+			targetFqdns = append(targetFqdns, v.(string))
+		}
+
+		protocols := make([]azureFirewallApplicationRuleProtocol, 0)
+		for _, v := range rule["protocol"].([]interface{}) {
+			protocol := v.(map[string]interface{})
+			protocols = append(protocols, azureFirewallApplicationRuleProtocol{
+				ProtocolType: protocol["type"].(string),
+				Port:         utils.Int32(int32(protocol["port"].(int))),
+			})
+		}
+
+		// the API requires that a rule either targets FQDN Tags, or Target FQDNs using the specified Protocols
+		if len(fqdnTags) > 0 && len(targetFqdns) > 0 {
+			return nil, fmt.Errorf("`fqdn_tags` and `target_fqdns` cannot both be specified for Rule %q", name)
+		}
+		if len(fqdnTags) == 0 && len(targetFqdns) == 0 {
+			return nil, fmt.Errorf("One of `fqdn_tags` or `target_fqdns` must be specified for Rule %q", name)
+		}
+		if len(fqdnTags) > 0 && len(protocols) > 0 {
+			return nil, fmt.Errorf("`protocol` cannot be specified with `fqdn_tags` for Rule %q", name)
+		}
+		if len(targetFqdns) > 0 && len(protocols) == 0 {
+			return nil, fmt.Errorf("At least one `protocol` must be specified with `target_fqdns` for Rule %q", name)
+		}
+
+		ruleToAdd := azureFirewallApplicationRule{
+			Name:            utils.String(name),
+			Description:     utils.String(description),
+			SourceAddresses: &sourceAddresses,
+			FqdnTags:        &fqdnTags,
+			TargetFqdns:     &targetFqdns,
+			Protocols:       &protocols,
+		}
+		rules = append(rules, ruleToAdd)
+	}
+
+	return rules, nil
+}
+
+func flattenFirewallApplicationRuleCollectionRules(rules *[]azureFirewallApplicationRule) []map[string]interface{} {
+	outputs := make([]map[string]interface{}, 0)
+	if rules == nil {
+		return outputs
+	}
+
+	for _, rule := range *rules {
+		output := make(map[string]interface{})
+		if rule.Name != nil {
+			output["name"] = *rule.Name
+		}
+		if rule.Description != nil {
+			output["description"] = *rule.Description
+		}
+		if rule.SourceAddresses != nil {
+			output["source_addresses"] = sliceToSet(*rule.SourceAddresses)
+		}
+		if rule.FqdnTags != nil {
+			output["fqdn_tags"] = sliceToSet(*rule.FqdnTags)
+		}
+		if rule.TargetFqdns != nil {
+			output["target_fqdns"] = sliceToSet(*rule.TargetFqdns)
+		}
+		protocols := make([]interface{}, 0)
+		if rule.Protocols != nil {
+			for _, protocol := range *rule.Protocols {
+				p := map[string]interface{}{
+					"type": protocol.ProtocolType,
+				}
+				if protocol.Port != nil {
+					p["port"] = int(*protocol.Port)
+				}
+				protocols = append(protocols, p)
+			}
+		}
+		output["protocol"] = protocols
+		outputs = append(outputs, output)
+	}
+	return outputs
+}
@@ -0,0 +1,401 @@
+package azurerm
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+)
+
+func TestExpandArmFirewallApplicationRules(t *testing.T) {
+	cases := []struct {
+		Name        string
+		FqdnTags    []interface{}
+		TargetFqdns []interface{}
+		Protocols   []interface{}
+		ExpectError bool
+	}{
+		{
+			Name:        "Target FQDNs",
+			TargetFqdns: []interface{}{"*.microsoft.com"},
+			Protocols: []interface{}{
+				map[string]interface{}{"type": "Https", "port": 443},
+			},
+		},
+		{
+			Name:     "FQDN Tags",
+			FqdnTags: []interface{}{"WindowsUpdate"},
+		},
+		{
+			Name:        "Neither",
+			ExpectError: true,
+		},
+		{
+			Name:        "Both",
+			FqdnTags:    []interface{}{"WindowsUpdate"},
+			TargetFqdns: []interface{}{"*.microsoft.com"},
+			Protocols: []interface{}{
+				map[string]interface{}{"type": "Https", "port": 443},
+			},
+			ExpectError: true,
+		},
+		{
+			Name:        "Target FQDNs without Protocols",
+			TargetFqdns: []interface{}{"*.microsoft.com"},
+			ExpectError: true,
+		},
+		{
+			Name:     "FQDN Tags with Protocols",
+			FqdnTags: []interface{}{"WindowsUpdate"},
+			Protocols: []interface{}{
+				map[string]interface{}{"type": "Https", "port": 443},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		rule := map[string]interface{}{
+			"name":             "rule1",
+			"description":      "",
+			"source_addresses": schema.NewSet(schema.HashString, []interface{}{"10.0.0.0/16"}),
+			"fqdn_tags":        schema.NewSet(schema.HashString, tc.FqdnTags),
+			"target_fqdns":     schema.NewSet(schema.HashString, tc.TargetFqdns),
+			"protocol":         tc.Protocols,
+		}
+		input := schema.NewSet(func(interface{}) int { return 0 }, []interface{}{rule})
+
+		rules, err := expandArmFirewallApplicationRules(input)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error for %q but didn't get one", tc.Name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Name, err)
+		}
+		if len(rules) != 1 {
+			t.Fatalf("Expected 1 Rule for %q but got %d", tc.Name, len(rules))
+		}
+	}
+}
+
+func TestUnitAzureRMFirewallApplicationRuleCollection_multiple(t *testing.T) {
+	firstResourceName := "azurerm_firewall_application_rule_collection.test"
+	secondResourceName := "azurerm_firewall_application_rule_collection.test_add"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+	firewallId := testFakeAzureFirewall(server, ri, location)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallApplicationRuleCollection_fakeFirewall(ri, location, testAccAzureRMFirewallApplicationRuleCollection_multiple()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(firstResourceName, "id", firewallId+"/applicationRuleCollections/acctestarc"),
+					resource.TestCheckResourceAttr(firstResourceName, "priority", "100"),
+					resource.TestCheckResourceAttr(firstResourceName, "action", "Allow"),
+					resource.TestCheckResourceAttr(firstResourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(secondResourceName, "id", firewallId+"/applicationRuleCollections/acctestarc_add"),
+					resource.TestCheckResourceAttr(secondResourceName, "priority", "200"),
+					resource.TestCheckResourceAttr(secondResourceName, "action", "Deny"),
+					resource.TestCheckResourceAttr(secondResourceName, "rule.#", "1"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "applicationRuleCollections", "acctestarc", "acctestarc_add"),
+					// the properties of the Firewall which aren't managed by the Rule Collection are retained
+					testCheckFakeFirewallRuleCollections(server, firewallId, "networkRuleCollections", "existing"),
+					testCheckFakeFirewallProperty(server, firewallId, "threatIntelMode", "Alert"),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallApplicationRuleCollection_fakeFirewall(ri, location, testAccAzureRMFirewallApplicationRuleCollection_basic()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(firstResourceName, "rule.#", "1"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "applicationRuleCollections", "acctestarc"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "networkRuleCollections", "existing"),
+				),
+			},
+			{
+				ResourceName:      firstResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallApplicationRuleCollection_basic(t *testing.T) {
+	resourceName := "azurerm_firewall_application_rule_collection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallApplicationRuleCollection_firewall(ri, location, testAccAzureRMFirewallApplicationRuleCollection_basic()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallApplicationRuleCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "acctestarc"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "100"),
+					resource.TestCheckResourceAttr(resourceName, "action", "Allow"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallApplicationRuleCollection_multiple(t *testing.T) {
+	firstResourceName := "azurerm_firewall_application_rule_collection.test"
+	secondResourceName := "azurerm_firewall_application_rule_collection.test_add"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallApplicationRuleCollection_firewall(ri, location, testAccAzureRMFirewallApplicationRuleCollection_multiple()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallApplicationRuleCollectionExists(firstResourceName),
+					testCheckAzureRMFirewallApplicationRuleCollectionExists(secondResourceName),
+					resource.TestCheckResourceAttr(secondResourceName, "priority", "200"),
+					resource.TestCheckResourceAttr(secondResourceName, "action", "Deny"),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallApplicationRuleCollection_firewall(ri, location, testAccAzureRMFirewallApplicationRuleCollection_basic()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallApplicationRuleCollectionExists(firstResourceName),
+				),
+			},
+		},
+	})
+}
+
+// testFakeAzureFirewall stubs an Azure Firewall with an existing Network Rule Collection in the fake API
+func testFakeAzureFirewall(server *fakearm.Server, rInt int, location string) string {
+	testFakeAzureFirewallRuleCollectionIds(server)
+
+	firewallId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/azureFirewalls/acctestfirewall%d", fakearm.SubscriptionID, rInt, rInt)
+	server.Put(firewallId, map[string]interface{}{
+		"location": location,
+		"properties": map[string]interface{}{
+			"threatIntelMode": "Alert",
+			"ipConfigurations": []interface{}{
+				map[string]interface{}{
+					"name": "configuration",
+					"properties": map[string]interface{}{
+						"subnet": map[string]interface{}{
+							"id": fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/virtualNetworks/acctestvirtnet%d/subnets/AzureFirewallSubnet", fakearm.SubscriptionID, rInt, rInt),
+						},
+						"publicIPAddress": map[string]interface{}{
+							"id": fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/publicIPAddresses/acctestpip%d", fakearm.SubscriptionID, rInt, rInt),
+						},
+					},
+				},
+			},
+			"networkRuleCollections": []interface{}{
+				map[string]interface{}{
+					"name": "existing",
+					"properties": map[string]interface{}{
+						"priority": 300,
+					},
+				},
+			},
+		},
+	})
+
+	return firewallId
+}
+
+// testFakeAzureFirewallRuleCollectionIds makes the fake API assign an ID to each Rule Collection within a Firewall,
+// as the real API does
+func testFakeAzureFirewallRuleCollectionIds(server *fakearm.Server) {
+	server.Computed("Microsoft.Network/azureFirewalls", func(firewall map[string]interface{}) {
+		properties := firewall["properties"].(map[string]interface{})
+		for _, key := range []string{"applicationRuleCollections", "natRuleCollections", "networkRuleCollections"} {
+			collections, _ := properties[key].([]interface{})
+			for _, v := range collections {
+				collection := v.(map[string]interface{})
+				collection["id"] = fmt.Sprintf("%s/%s/%s", firewall["id"], key, collection["name"])
+			}
+		}
+	})
+}
+
+func testCheckFakeFirewallRuleCollections(server *fakearm.Server, firewallId string, key string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		firewall, exists := server.Get(firewallId)
+		if !exists {
+			return fmt.Errorf("Bad: Firewall %q does not exist in the fake Resource Manager API", firewallId)
+		}
+
+		actual := make([]string, 0)
+		collections, _ := firewall["properties"].(map[string]interface{})[key].([]interface{})
+		for _, v := range collections {
+			actual = append(actual, v.(map[string]interface{})["name"].(string))
+		}
+
+		// Rule Collections can be created in any order
+		sort.Strings(actual)
+		sort.Strings(names)
+		if fmt.Sprintf("%v", actual) != fmt.Sprintf("%v", names) {
+			return fmt.Errorf("Bad: expected the %s of Firewall %q to be %v but got %v", key, firewallId, names, actual)
+		}
+
+		return nil
+	}
+}
+
+func testCheckFakeFirewallProperty(server *fakearm.Server, firewallId string, key string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		firewall, exists := server.Get(firewallId)
+		if !exists {
+			return fmt.Errorf("Bad: Firewall %q does not exist in the fake Resource Manager API", firewallId)
+		}
+
+		if actual := firewall["properties"].(map[string]interface{})[key]; actual != expected {
+			return fmt.Errorf("Bad: expected `properties.%s` of Firewall %q to be %q but got %v", key, firewallId, expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFirewallApplicationRuleCollectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		firewallName := rs.Primary.Attributes["azure_firewall_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).firewallRuleCollectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		read, err := client.Get(ctx, resourceGroup, firewallName)
+		if err != nil {
+			return err
+		}
+
+		collections, err := read.ApplicationRuleCollections()
+		if err != nil {
+			return err
+		}
+
+		for _, collection := range collections {
+			if collection.Name != nil && *collection.Name == name {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Expected Application Rule Collection %q (Firewall %q / Resource Group %q) to exist but it didn't", name, firewallName, resourceGroup)
+	}
+}
+
+func testAccAzureRMFirewallApplicationRuleCollection_firewall(rInt int, location string, ruleCollections string) string {
+	template := testAccAzureRMFirewall_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+locals {
+  azure_firewall_name = "${azurerm_firewall.test.name}"
+}
+
+%s
+`, template, ruleCollections)
+}
+
+func testAccAzureRMFirewallApplicationRuleCollection_fakeFirewall(rInt int, location string, ruleCollections string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+locals {
+  azure_firewall_name = "acctestfirewall%d"
+}
+
+%s
+`, rInt, location, rInt, ruleCollections)
+}
+
+func testAccAzureRMFirewallApplicationRuleCollection_basic() string {
+	return `
+resource "azurerm_firewall_application_rule_collection" "test" {
+  name                = "acctestarc"
+  azure_firewall_name = "${local.azure_firewall_name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  priority            = 100
+  action              = "Allow"
+
+  rule {
+    name = "rule1"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    target_fqdns = [
+      "*.google.com",
+    ]
+
+    protocol {
+      port = 443
+      type = "Https"
+    }
+  }
+}
+`
+}
+
+func testAccAzureRMFirewallApplicationRuleCollection_multiple() string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_application_rule_collection" "test_add" {
+  name                = "acctestarc_add"
+  azure_firewall_name = "${local.azure_firewall_name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  priority            = 200
+  action              = "Deny"
+
+  rule {
+    name = "rule1"
+
+    source_addresses = [
+      "192.168.0.1",
+    ]
+
+    fqdn_tags = [
+      "WindowsUpdate",
+    ]
+  }
+}
+`, testAccAzureRMFirewallApplicationRuleCollection_basic())
+}
@@ -0,0 +1,443 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmFirewallNatRuleCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmFirewallNatRuleCollectionCreateUpdate,
+		Read:   resourceArmFirewallNatRuleCollectionRead,
+		Update: resourceArmFirewallNatRuleCollectionCreateUpdate,
+		Delete: resourceArmFirewallNatRuleCollectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureFirewallName,
+			},
+
+			"azure_firewall_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureFirewallName,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(100, 65000),
+			},
+
+			"action": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Dnat",
+					"Snat",
+				}, false),
+			},
+
+			"rule": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"source_addresses": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"destination_addresses": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"destination_ports": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+						},
+						"protocols": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(network.Any),
+									string(network.ICMP),
+									string(network.TCP),
+									string(network.UDP),
+								}, false),
+							},
+							Set: schema.HashString,
+						},
+						"translated_address": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						"translated_port": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmFirewallNatRuleCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).firewallRuleCollectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	firewallName := d.Get("azure_firewall_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	azureRMLockByName(firewallName, azureFirewallResourceName)
+	defer azureRMUnlockByName(firewallName, azureFirewallResourceName)
+
+	firewall, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	if firewall.Properties == nil {
+		return fmt.Errorf("Error expanding Firewall %q (Resource Group %q): `properties` was nil.", firewallName, resourceGroup)
+	}
+
+	ruleCollections, err := firewall.NatRuleCollections()
+	if err != nil {
+		return fmt.Errorf("Error expanding Firewall %q (Resource Group %q): `properties.natRuleCollections` was invalid: %+v", firewallName, resourceGroup, err)
+	}
+
+	natRules := expandArmFirewallNatRules(d.Get("rule").(*schema.Set))
+	priority := d.Get("priority").(int)
+	newRuleCollection := azureFirewallNatRuleCollection{
+		Name: utils.String(name),
+		Properties: &azureFirewallNatRuleCollectionProperties{
+			Action: &azureFirewallRCAction{
+				Type: d.Get("action").(string),
+			},
+			Priority: utils.Int32(int32(priority)),
+			Rules:    &natRules,
+		},
+	}
+
+	if !d.IsNewResource() {
+		index := -1
+		for i, v := range ruleCollections {
+			if v.Name == nil {
+				continue
+			}
+
+			if *v.Name == name {
+				index = i
+				break
+			}
+		}
+
+		if index == -1 {
+			return fmt.Errorf("Error locating NAT Rule Collection %q (Firewall %q / Resource Group %q)", name, firewallName, resourceGroup)
+		}
+
+		ruleCollections[index] = newRuleCollection
+	} else {
+		ruleCollections = append(ruleCollections, newRuleCollection)
+	}
+
+	if err := firewall.SetNatRuleCollections(ruleCollections); err != nil {
+		return fmt.Errorf("Error setting NAT Rule Collections for Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, firewallName, firewall)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating NAT Rule Collection %q in Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletionRef(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for creation/update of NAT Rule Collection %q of Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	collections, err := read.NatRuleCollections()
+	if err != nil {
+		return fmt.Errorf("Error retrieving NAT Rule Collections for Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	var collectionID string
+	for _, collection := range collections {
+		if collection.Name == nil || collection.ID == nil {
+			continue
+		}
+
+		if *collection.Name == name {
+			collectionID = *collection.ID
+			break
+		}
+	}
+
+	if collectionID == "" {
+		return fmt.Errorf("Cannot find ID for NAT Rule Collection %q (Azure Firewall %q / Resource Group %q)", name, firewallName, resourceGroup)
+	}
+	d.SetId(collectionID)
+
+	return resourceArmFirewallNatRuleCollectionRead(d, meta)
+}
+
+func resourceArmFirewallNatRuleCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).firewallRuleCollectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	firewallName := id.Path["azureFirewalls"]
+	name := id.Path["natRuleCollections"]
+
+	read, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] Azure Firewall %q (Resource Group %q) was not found - removing from state!", firewallName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Azure Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	collections, err := read.NatRuleCollections()
+	if err != nil {
+		return fmt.Errorf("Error retrieving NAT Rule Collection %q (Firewall %q / Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	var rule *azureFirewallNatRuleCollection
+	for _, r := range collections {
+		if r.Name == nil {
+			continue
+		}
+
+		if *r.Name == name {
+			rule = &r
+			break
+		}
+	}
+
+	if rule == nil {
+		log.Printf("[DEBUG] NAT Rule Collection %q was not found on Firewall %q (Resource Group %q) - removing from state!", name, firewallName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("azure_firewall_name", firewallName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := rule.Properties; props != nil {
+		if action := props.Action; action != nil {
+			d.Set("action", action.Type)
+		}
+
+		if priority := props.Priority; priority != nil {
+			d.Set("priority", int(*priority))
+		}
+
+		flattenedRules := flattenFirewallNatRuleCollectionRules(props.Rules)
+		if err := d.Set("rule", flattenedRules); err != nil {
+			return fmt.Errorf("Error setting `rule`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmFirewallNatRuleCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).firewallRuleCollectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	firewallName := id.Path["azureFirewalls"]
+	name := id.Path["natRuleCollections"]
+
+	azureRMLockByName(firewallName, azureFirewallResourceName)
+	defer azureRMUnlockByName(firewallName, azureFirewallResourceName)
+
+	firewall, err := client.Get(ctx, resourceGroup, firewallName)
+	if err != nil {
+		if utils.ResponseWasNotFound(firewall.Response) {
+			// assume deleted
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Azure Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	collections, err := firewall.NatRuleCollections()
+	if err != nil {
+		return fmt.Errorf("Error retrieving NAT Rule Collection %q (Firewall %q / Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	natRules := make([]azureFirewallNatRuleCollection, 0)
+	for _, rule := range collections {
+		if rule.Name == nil {
+			continue
+		}
+
+		if *rule.Name != name {
+			natRules = append(natRules, rule)
+		}
+	}
+
+	if err := firewall.SetNatRuleCollections(natRules); err != nil {
+		return fmt.Errorf("Error setting NAT Rule Collections for Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, firewallName, firewall)
+	if err != nil {
+		return fmt.Errorf("Error deleting NAT Rule Collection %q from Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletionRef(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for deletion of NAT Rule Collection %q from Firewall %q (Resource Group %q): %+v", name, firewallName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmFirewallNatRules(input *schema.Set) []azureFirewallNatRule {
+	natRules := input.List()
+	rules := make([]azureFirewallNatRule, 0)
+
+	for _, natRule := range natRules {
+		rule := natRule.(map[string]interface{})
+
+		name := rule["name"].(string)
+		description := rule["description"].(string)
+
+		sourceAddresses := make([]string, 0)
+		for _, v := range rule["source_addresses"].(*schema.Set).List() {
+			sourceAddresses = append(sourceAddresses, v.(string))
+		}
+
+		destinationAddresses := make([]string, 0)
+		for _, v := range rule["destination_addresses"].(*schema.Set).List() {
+			destinationAddresses = append(destinationAddresses, v.(string))
+		}
+
+		destinationPorts := make([]string, 0)
+		for _, v := range rule["destination_ports"].(*schema.Set).List() {
+			destinationPorts = append(destinationPorts, v.(string))
+		}
+
+		protocols := make([]string, 0)
+		for _, v := range rule["protocols"].(*schema.Set).List() {
+			protocols = append(protocols, v.(string))
+		}
+
+		ruleToAdd := azureFirewallNatRule{
+			Name:                 utils.String(name),
+			Description:          utils.String(description),
+			SourceAddresses:      &sourceAddresses,
+			DestinationAddresses: &destinationAddresses,
+			DestinationPorts:     &destinationPorts,
+			Protocols:            &protocols,
+			TranslatedAddress:    utils.String(rule["translated_address"].(string)),
+			TranslatedPort:       utils.String(rule["translated_port"].(string)),
+		}
+		rules = append(rules, ruleToAdd)
+	}
+
+	return rules
+}
+
+func flattenFirewallNatRuleCollectionRules(rules *[]azureFirewallNatRule) []map[string]interface{} {
+	outputs := make([]map[string]interface{}, 0)
+	if rules == nil {
+		return outputs
+	}
+
+	for _, rule := range *rules {
+		output := make(map[string]interface{})
+		if rule.Name != nil {
+			output["name"] = *rule.Name
+		}
+		if rule.Description != nil {
+			output["description"] = *rule.Description
+		}
+		if rule.SourceAddresses != nil {
+			output["source_addresses"] = sliceToSet(*rule.SourceAddresses)
+		}
+		if rule.DestinationAddresses != nil {
+			output["destination_addresses"] = sliceToSet(*rule.DestinationAddresses)
+		}
+		if rule.DestinationPorts != nil {
+			output["destination_ports"] = sliceToSet(*rule.DestinationPorts)
+		}
+		if rule.Protocols != nil {
+			output["protocols"] = sliceToSet(*rule.Protocols)
+		}
+		if rule.TranslatedAddress != nil {
+			output["translated_address"] = *rule.TranslatedAddress
+		}
+		if rule.TranslatedPort != nil {
+			output["translated_port"] = *rule.TranslatedPort
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs
+}
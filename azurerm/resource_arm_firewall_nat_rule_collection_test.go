@@ -0,0 +1,243 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+)
+
+func TestUnitAzureRMFirewallNatRuleCollection_update(t *testing.T) {
+	resourceName := "azurerm_firewall_nat_rule_collection.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+	firewallId := testFakeAzureFirewall(server, ri, location)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallApplicationRuleCollection_fakeFirewall(ri, location, testAccAzureRMFirewallNatRuleCollection_basic()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", firewallId+"/natRuleCollections/acctestnrc"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "100"),
+					resource.TestCheckResourceAttr(resourceName, "action", "Dnat"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "natRuleCollections", "acctestnrc"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "networkRuleCollections", "existing"),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallApplicationRuleCollection_fakeFirewall(ri, location, testAccAzureRMFirewallNatRuleCollection_withApplicationRuleCollection()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "200"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					// each kind of Rule Collection is managed independently of the others
+					testCheckFakeFirewallRuleCollections(server, firewallId, "natRuleCollections", "acctestnrc"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "applicationRuleCollections", "acctestarc"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "networkRuleCollections", "existing"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallNatRuleCollection_basic(t *testing.T) {
+	resourceName := "azurerm_firewall_nat_rule_collection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallApplicationRuleCollection_firewall(ri, location, testAccAzureRMFirewallNatRuleCollection_basic()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallNatRuleCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "acctestnrc"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "100"),
+					resource.TestCheckResourceAttr(resourceName, "action", "Dnat"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallNatRuleCollection_update(t *testing.T) {
+	resourceName := "azurerm_firewall_nat_rule_collection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallApplicationRuleCollection_firewall(ri, location, testAccAzureRMFirewallNatRuleCollection_basic()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallNatRuleCollectionExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallApplicationRuleCollection_firewall(ri, location, testAccAzureRMFirewallNatRuleCollection_withApplicationRuleCollection()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallNatRuleCollectionExists(resourceName),
+					testCheckAzureRMFirewallApplicationRuleCollectionExists("azurerm_firewall_application_rule_collection.test"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "200"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMFirewallNatRuleCollectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		firewallName := rs.Primary.Attributes["azure_firewall_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).firewallRuleCollectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		read, err := client.Get(ctx, resourceGroup, firewallName)
+		if err != nil {
+			return err
+		}
+
+		collections, err := read.NatRuleCollections()
+		if err != nil {
+			return err
+		}
+
+		for _, collection := range collections {
+			if collection.Name != nil && *collection.Name == name {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Expected NAT Rule Collection %q (Firewall %q / Resource Group %q) to exist but it didn't", name, firewallName, resourceGroup)
+	}
+}
+
+func testAccAzureRMFirewallNatRuleCollection_basic() string {
+	return `
+resource "azurerm_firewall_nat_rule_collection" "test" {
+  name                = "acctestnrc"
+  azure_firewall_name = "${local.azure_firewall_name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  priority            = 100
+  action              = "Dnat"
+
+  rule {
+    name = "rule1"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    destination_ports = [
+      "53",
+    ]
+
+    destination_addresses = [
+      "8.8.8.8",
+    ]
+
+    protocols = [
+      "TCP",
+      "UDP",
+    ]
+
+    translated_address = "8.8.4.4"
+    translated_port    = "53"
+  }
+}
+`
+}
+
+func testAccAzureRMFirewallNatRuleCollection_withApplicationRuleCollection() string {
+	return fmt.Sprintf(`
+resource "azurerm_firewall_nat_rule_collection" "test" {
+  name                = "acctestnrc"
+  azure_firewall_name = "${local.azure_firewall_name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  priority            = 200
+  action              = "Dnat"
+
+  rule {
+    name = "rule1"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    destination_ports = [
+      "53",
+    ]
+
+    destination_addresses = [
+      "8.8.8.8",
+    ]
+
+    protocols = [
+      "TCP",
+      "UDP",
+    ]
+
+    translated_address = "8.8.4.4"
+    translated_port    = "53"
+  }
+
+  rule {
+    name = "rule2"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    destination_ports = [
+      "8080",
+    ]
+
+    destination_addresses = [
+      "8.8.8.8",
+    ]
+
+    protocols = [
+      "TCP",
+    ]
+
+    translated_address = "10.0.0.4"
+    translated_port    = "80"
+  }
+}
+
+%s
+`, testAccAzureRMFirewallApplicationRuleCollection_basic())
+}
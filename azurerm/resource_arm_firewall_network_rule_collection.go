@@ -3,11 +3,12 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -21,6 +22,13 @@ func resourceArmFirewallNetworkRuleCollection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -108,8 +116,9 @@ func resourceArmFirewallNetworkRuleCollection() *schema.Resource {
 }
 
 func resourceArmFirewallNetworkRuleCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).firewallRuleCollectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	firewallName := d.Get("azure_firewall_name").(string)
@@ -123,29 +132,22 @@ func resourceArmFirewallNetworkRuleCollectionCreateUpdate(d *schema.ResourceData
 		return fmt.Errorf("Error retrieving Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
 	}
 
-	if firewall.AzureFirewallPropertiesFormat == nil {
+	if firewall.Properties == nil {
 		return fmt.Errorf("Error expanding Firewall %q (Resource Group %q): `properties` was nil.", firewallName, resourceGroup)
 	}
-	props := *firewall.AzureFirewallPropertiesFormat
-
-	if props.NetworkRuleCollections == nil {
-		return fmt.Errorf("Error expanding Firewall %q (Resource Group %q): `properties.NetworkRuleCollections` was nil.", firewallName, resourceGroup)
-	}
-	ruleCollections := *props.NetworkRuleCollections
 
-	ipConfigurations, err := azure.FirewallFixIPConfiguration(props.IPConfigurations)
+	ruleCollections, err := firewall.NetworkRuleCollections()
 	if err != nil {
-		return fmt.Errorf("Error fixing IP Configurations for Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+		return fmt.Errorf("Error expanding Firewall %q (Resource Group %q): `properties.networkRuleCollections` was invalid: %+v", firewallName, resourceGroup, err)
 	}
-	firewall.AzureFirewallPropertiesFormat.IPConfigurations = ipConfigurations
 
 	networkRules := expandArmFirewallNetworkRules(d.Get("rule").(*schema.Set))
 	priority := d.Get("priority").(int)
-	newRuleCollection := network.AzureFirewallNetworkRuleCollection{
+	newRuleCollection := azureFirewallNetworkRuleCollection{
 		Name: utils.String(name),
-		AzureFirewallNetworkRuleCollectionPropertiesFormat: &network.AzureFirewallNetworkRuleCollectionPropertiesFormat{
-			Action: &network.AzureFirewallRCAction{
-				Type: network.AzureFirewallRCActionType(d.Get("action").(string)),
+		Properties: &azureFirewallNetworkRuleCollectionProperties{
+			Action: &azureFirewallRCAction{
+				Type: d.Get("action").(string),
 			},
 			Priority: utils.Int32(int32(priority)),
 			Rules:    &networkRules,
@@ -154,16 +156,14 @@ func resourceArmFirewallNetworkRuleCollectionCreateUpdate(d *schema.ResourceData
 
 	if !d.IsNewResource() {
 		index := -1
-		if ruleCollections != nil {
-			for i, v := range ruleCollections {
-				if v.Name == nil {
-					continue
-				}
-
-				if *v.Name == name {
-					index = i
-					break
-				}
+		for i, v := range ruleCollections {
+			if v.Name == nil {
+				continue
+			}
+
+			if *v.Name == name {
+				index = i
+				break
 			}
 		}
 
@@ -176,7 +176,9 @@ func resourceArmFirewallNetworkRuleCollectionCreateUpdate(d *schema.ResourceData
 		ruleCollections = append(ruleCollections, newRuleCollection)
 	}
 
-	firewall.AzureFirewallPropertiesFormat.NetworkRuleCollections = &ruleCollections
+	if err := firewall.SetNetworkRuleCollections(ruleCollections); err != nil {
+		return fmt.Errorf("Error setting Network Rule Collections for Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, firewallName, firewall)
 	if err != nil {
@@ -193,19 +195,20 @@ func resourceArmFirewallNetworkRuleCollectionCreateUpdate(d *schema.ResourceData
 		return fmt.Errorf("Error retrieving Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
 	}
 
+	collections, err := read.NetworkRuleCollections()
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Rule Collections for Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	}
+
 	var collectionID string
-	if props := read.AzureFirewallPropertiesFormat; props != nil {
-		if collections := props.NetworkRuleCollections; collections != nil {
-			for _, collection := range *collections {
-				if collection.Name == nil {
-					continue
-				}
-
-				if *collection.Name == name {
-					collectionID = *collection.ID
-					break
-				}
-			}
+	for _, collection := range collections {
+		if collection.Name == nil || collection.ID == nil {
+			continue
+		}
+
+		if *collection.Name == name {
+			collectionID = *collection.ID
+			break
 		}
 	}
 
@@ -218,8 +221,9 @@ func resourceArmFirewallNetworkRuleCollectionCreateUpdate(d *schema.ResourceData
 }
 
 func resourceArmFirewallNetworkRuleCollectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).firewallRuleCollectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -233,24 +237,20 @@ func resourceArmFirewallNetworkRuleCollectionRead(d *schema.ResourceData, meta i
 	read, err := client.Get(ctx, resourceGroup, firewallName)
 	if err != nil {
 		if utils.ResponseWasNotFound(read.Response) {
-			log.Printf("[DEBUG] Azure Firewall %q (Resource Group %q) was not found - removing from state!", name, resourceGroup)
+			log.Printf("[DEBUG] Azure Firewall %q (Resource Group %q) was not found - removing from state!", firewallName, resourceGroup)
 			d.SetId("")
 			return nil
 		}
-		return fmt.Errorf("Error retrieving Azure Firewall %q (Resource Group %q): %+v", name, resourceGroup, err)
-	}
-
-	if read.AzureFirewallPropertiesFormat == nil {
-		return fmt.Errorf("Error retrieving Network Rule Collection %q (Firewall %q / Resource Group %q): `props` was nil", name, firewallName, resourceGroup)
+		return fmt.Errorf("Error retrieving Azure Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
 	}
-	props := *read.AzureFirewallPropertiesFormat
 
-	if props.NetworkRuleCollections == nil {
-		return fmt.Errorf("Error retrieving Network Rule Collection %q (Firewall %q / Resource Group %q): `props.NetworkRuleCollections` was nil", name, firewallName, resourceGroup)
+	collections, err := read.NetworkRuleCollections()
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Rule Collection %q (Firewall %q / Resource Group %q): %+v", name, firewallName, resourceGroup, err)
 	}
 
-	var rule *network.AzureFirewallNetworkRuleCollection
-	for _, r := range *props.NetworkRuleCollections {
+	var rule *azureFirewallNetworkRuleCollection
+	for _, r := range collections {
 		if r.Name == nil {
 			continue
 		}
@@ -271,9 +271,9 @@ func resourceArmFirewallNetworkRuleCollectionRead(d *schema.ResourceData, meta i
 	d.Set("azure_firewall_name", firewallName)
 	d.Set("resource_group_name", resourceGroup)
 
-	if props := rule.AzureFirewallNetworkRuleCollectionPropertiesFormat; props != nil {
+	if props := rule.Properties; props != nil {
 		if action := props.Action; action != nil {
-			d.Set("action", string(action.Type))
+			d.Set("action", action.Type)
 		}
 
 		if priority := props.Priority; priority != nil {
@@ -290,8 +290,9 @@ func resourceArmFirewallNetworkRuleCollectionRead(d *schema.ResourceData, meta i
 }
 
 func resourceArmFirewallNetworkRuleCollectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).firewallRuleCollectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -315,16 +316,13 @@ func resourceArmFirewallNetworkRuleCollectionDelete(d *schema.ResourceData, meta
 		return fmt.Errorf("Error making Read request on Azure Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
 	}
 
-	props := firewall.AzureFirewallPropertiesFormat
-	if props == nil {
-		return fmt.Errorf("Error retrieving Network Rule Collection %q (Firewall %q / Resource Group %q): `props` was nil", name, firewallName, resourceGroup)
-	}
-	if props.NetworkRuleCollections == nil {
-		return fmt.Errorf("Error retrieving Network Rule Collection %q (Firewall %q / Resource Group %q): `props.NetworkRuleCollections` was nil", name, firewallName, resourceGroup)
+	collections, err := firewall.NetworkRuleCollections()
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Rule Collection %q (Firewall %q / Resource Group %q): %+v", name, firewallName, resourceGroup, err)
 	}
 
-	networkRules := make([]network.AzureFirewallNetworkRuleCollection, 0)
-	for _, rule := range *props.NetworkRuleCollections {
+	networkRules := make([]azureFirewallNetworkRuleCollection, 0)
+	for _, rule := range collections {
 		if rule.Name == nil {
 			continue
 		}
@@ -333,13 +331,10 @@ func resourceArmFirewallNetworkRuleCollectionDelete(d *schema.ResourceData, meta
 			networkRules = append(networkRules, rule)
 		}
 	}
-	props.NetworkRuleCollections = &networkRules
 
-	ipConfigs, err := azure.FirewallFixIPConfiguration(props.IPConfigurations)
-	if err != nil {
-		return fmt.Errorf("Error fixing IP Configuration for Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
+	if err := firewall.SetNetworkRuleCollections(networkRules); err != nil {
+		return fmt.Errorf("Error setting Network Rule Collections for Firewall %q (Resource Group %q): %+v", firewallName, resourceGroup, err)
 	}
-	props.IPConfigurations = ipConfigs
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, firewallName, firewall)
 	if err != nil {
@@ -354,9 +349,9 @@ func resourceArmFirewallNetworkRuleCollectionDelete(d *schema.ResourceData, meta
 	return nil
 }
 
-func expandArmFirewallNetworkRules(input *schema.Set) []network.AzureFirewallNetworkRule {
+func expandArmFirewallNetworkRules(input *schema.Set) []azureFirewallNetworkRule {
 	nwRules := input.List()
-	rules := make([]network.AzureFirewallNetworkRule, 0)
+	rules := make([]azureFirewallNetworkRule, 0)
 
 	for _, nwRule := range nwRules {
 		rule := nwRule.(map[string]interface{})
@@ -379,28 +374,26 @@ func expandArmFirewallNetworkRules(input *schema.Set) []network.AzureFirewallNet
 			destinationPorts = append(destinationPorts, v.(string))
 		}
 
-		ruleToAdd := network.AzureFirewallNetworkRule{
+		protocols := make([]string, 0)
+		for _, v := range rule["protocols"].(*schema.Set).List() {
+			protocols = append(protocols, v.(string))
+		}
+
+		ruleToAdd := azureFirewallNetworkRule{
 			Name:                 utils.String(name),
 			Description:          utils.String(description),
 			SourceAddresses:      &sourceAddresses,
 			DestinationAddresses: &destinationAddresses,
 			DestinationPorts:     &destinationPorts,
+			Protocols:            &protocols,
 		}
-
-		nrProtocols := make([]network.AzureFirewallNetworkRuleProtocol, 0)
-		protocols := rule["protocols"].(*schema.Set)
-		for _, v := range protocols.List() {
-			s := network.AzureFirewallNetworkRuleProtocol(v.(string))
-			nrProtocols = append(nrProtocols, s)
-		}
-		ruleToAdd.Protocols = &nrProtocols
 		rules = append(rules, ruleToAdd)
 	}
 
 	return rules
 }
 
-func flattenFirewallNetworkRuleCollectionRules(rules *[]network.AzureFirewallNetworkRule) []map[string]interface{} {
+func flattenFirewallNetworkRuleCollectionRules(rules *[]azureFirewallNetworkRule) []map[string]interface{} {
 	outputs := make([]map[string]interface{}, 0)
 	if rules == nil {
 		return outputs
@@ -425,9 +418,7 @@ func flattenFirewallNetworkRuleCollectionRules(rules *[]network.AzureFirewallNet
 		}
 		protocols := make([]string, 0)
 		if rule.Protocols != nil {
-			for _, protocol := range *rule.Protocols {
-				protocols = append(protocols, string(protocol))
-			}
+			protocols = *rule.Protocols
 		}
 		output["protocols"] = sliceToSet(protocols)
 		outputs = append(outputs, output)
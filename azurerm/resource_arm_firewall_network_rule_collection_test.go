@@ -9,8 +9,74 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
 )
 
+func TestUnitAzureRMFirewallNetworkRuleCollection_withOtherRuleCollections(t *testing.T) {
+	resourceName := "azurerm_firewall_network_rule_collection.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+	firewallId := testFakeAzureFirewall(server, ri, location)
+
+	otherRuleCollections := fmt.Sprintf("%s\n%s", testAccAzureRMFirewallNatRuleCollection_basic(), testAccAzureRMFirewallApplicationRuleCollection_multiple())
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallApplicationRuleCollection_fakeFirewall(ri, location, otherRuleCollections),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeFirewallRuleCollections(server, firewallId, "natRuleCollections", "acctestnrc"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "applicationRuleCollections", "acctestarc", "acctestarc_add"),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallApplicationRuleCollection_fakeFirewall(ri, location, testAccAzureRMFirewallNetworkRuleCollection_fakeFirewall(otherRuleCollections, 100)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", firewallId+"/networkRuleCollections/acctestnetrc"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "100"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "networkRuleCollections", "acctestnetrc", "existing"),
+					// the NAT and Application Rule Collections (including their FQDN's) are retained
+					testCheckFakeFirewallRuleCollections(server, firewallId, "natRuleCollections", "acctestnrc"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "applicationRuleCollections", "acctestarc", "acctestarc_add"),
+					testCheckFakeFirewallApplicationRuleFqdns(server, firewallId, "targetFqdns", "*.google.com"),
+					testCheckFakeFirewallApplicationRuleFqdns(server, firewallId, "fqdnTags", "WindowsUpdate"),
+					testCheckFakeFirewallProperty(server, firewallId, "threatIntelMode", "Alert"),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallApplicationRuleCollection_fakeFirewall(ri, location, testAccAzureRMFirewallNetworkRuleCollection_fakeFirewall(otherRuleCollections, 200)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "priority", "200"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "networkRuleCollections", "acctestnetrc", "existing"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "natRuleCollections", "acctestnrc"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "applicationRuleCollections", "acctestarc", "acctestarc_add"),
+					testCheckFakeFirewallApplicationRuleFqdns(server, firewallId, "targetFqdns", "*.google.com"),
+					testCheckFakeFirewallApplicationRuleFqdns(server, firewallId, "fqdnTags", "WindowsUpdate"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// removing the Network Rule Collection leaves the other Rule Collections as-is
+				Config: testAccAzureRMFirewallApplicationRuleCollection_fakeFirewall(ri, location, otherRuleCollections),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeFirewallRuleCollections(server, firewallId, "networkRuleCollections", "existing"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "natRuleCollections", "acctestnrc"),
+					testCheckFakeFirewallApplicationRuleFqdns(server, firewallId, "targetFqdns", "*.google.com"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMFirewallNetworkRuleCollection_basic(t *testing.T) {
 	resourceName := "azurerm_firewall_network_rule_collection.test"
 	ri := acctest.RandInt()
@@ -354,6 +420,67 @@ func testCheckAzureRMFirewallNetworkRuleCollectionDisappears(resourceName string
 	}
 }
 
+// testCheckFakeFirewallApplicationRuleFqdns checks that a Rule within the Application Rule Collections of the Firewall
+// has the specified value within its `targetFqdns` or `fqdnTags`
+func testCheckFakeFirewallApplicationRuleFqdns(server *fakearm.Server, firewallId string, key string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		firewall, exists := server.Get(firewallId)
+		if !exists {
+			return fmt.Errorf("Bad: Firewall %q does not exist in the fake Resource Manager API", firewallId)
+		}
+
+		collections, _ := firewall["properties"].(map[string]interface{})["applicationRuleCollections"].([]interface{})
+		for _, collection := range collections {
+			props, _ := collection.(map[string]interface{})["properties"].(map[string]interface{})
+			rules, _ := props["rules"].([]interface{})
+			for _, rule := range rules {
+				values, _ := rule.(map[string]interface{})[key].([]interface{})
+				for _, v := range values {
+					if v == expected {
+						return nil
+					}
+				}
+			}
+		}
+
+		return fmt.Errorf("Bad: expected an Application Rule of Firewall %q to have %q within `%s`", firewallId, expected, key)
+	}
+}
+
+func testAccAzureRMFirewallNetworkRuleCollection_fakeFirewall(otherRuleCollections string, priority int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_network_rule_collection" "test" {
+  name                = "acctestnetrc"
+  azure_firewall_name = "${local.azure_firewall_name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  priority            = %d
+  action              = "Allow"
+
+  rule {
+    name = "rule1"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    destination_ports = [
+      "53",
+    ]
+
+    destination_addresses = [
+      "8.8.8.8",
+    ]
+
+    protocols = [
+      "Any",
+    ]
+  }
+}
+`, otherRuleCollections, priority)
+}
+
 func testAccAzureRMFirewallNetworkRuleCollection_basic(rInt int, location string) string {
 	template := testAccAzureRMFirewall_basic(rInt, location)
 	return fmt.Sprintf(`
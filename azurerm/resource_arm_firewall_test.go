@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -111,6 +112,41 @@ func TestAccAzureRMFirewall_withTags(t *testing.T) {
 	})
 }
 
+func TestUnitAzureRMFirewall_updatedTagsWithRuleCollections(t *testing.T) {
+	resourceName := "azurerm_firewall.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+	testFakeAzureFirewallRuleCollectionIds(server)
+	firewallId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/azureFirewalls/acctestfirewall%d", fakearm.SubscriptionID, ri, ri)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewall_withRuleCollections(testAccAzureRMFirewall_withTags(ri, location)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "applicationRuleCollections", "acctestarc"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "networkRuleCollections", "acctestnetrc"),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewall_withRuleCollections(testAccAzureRMFirewall_withUpdatedTags(ri, location)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "staging"),
+					// updating the Firewall retains the Rule Collections
+					testCheckFakeFirewallRuleCollections(server, firewallId, "applicationRuleCollections", "acctestarc"),
+					testCheckFakeFirewallRuleCollections(server, firewallId, "networkRuleCollections", "acctestnetrc"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMFirewall_disappears(t *testing.T) {
 	resourceName := "azurerm_firewall.test"
 	ri := acctest.RandInt()
@@ -355,3 +391,15 @@ resource "azurerm_firewall" "test" {
 }
 `, rInt, location, rInt, rInt, rInt)
 }
+
+func testAccAzureRMFirewall_withRuleCollections(template string) string {
+	return fmt.Sprintf(`
+%s
+
+locals {
+  azure_firewall_name = "${azurerm_firewall.test.name}"
+}
+
+%s
+`, template, testAccAzureRMFirewallNetworkRuleCollection_fakeFirewall(testAccAzureRMFirewallApplicationRuleCollection_basic(), 100))
+}
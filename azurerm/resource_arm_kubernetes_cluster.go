@@ -5,15 +5,20 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+	agentPools "github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-02-01/containerservice"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/kubernetes"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var kubernetesClusterResourceName = "azurerm_kubernetes_cluster"
+
 func resourceArmKubernetesCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmKubernetesClusterCreate,
@@ -59,6 +64,13 @@ func resourceArmKubernetesCluster() *schema.Resource {
 			return nil
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -91,42 +103,16 @@ func resourceArmKubernetesCluster() *schema.Resource {
 				Computed: true,
 			},
 
-			"kube_config": {
-				Type:     schema.TypeList,
-				Computed: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"host": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"username": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"password": {
-							Type:      schema.TypeString,
-							Computed:  true,
-							Sensitive: true,
-						},
-						"client_certificate": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"client_key": {
-							Type:      schema.TypeString,
-							Computed:  true,
-							Sensitive: true,
-						},
-						"cluster_ca_certificate": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-					},
-				},
+			"kube_admin_config": kubernetesClusterKubeConfigSchema(),
+
+			"kube_admin_config_raw": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 
+			"kube_config": kubernetesClusterKubeConfigSchema(),
+
 			"kube_config_raw": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -177,10 +163,28 @@ func resourceArmKubernetesCluster() *schema.Resource {
 						},
 
 						"count": {
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          1,
+							ValidateFunc:     validation.IntBetween(1, 50),
+							DiffSuppressFunc: suppressKubernetesClusterAgentPoolCountWhenAutoScaling("agent_pool_profile.0.enable_auto_scaling"),
+						},
+
+						"enable_auto_scaling": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"min_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+
+						"max_count": {
 							Type:         schema.TypeInt,
 							Optional:     true,
-							Default:      1,
-							ValidateFunc: validation.IntBetween(1, 50),
+							ValidateFunc: validation.IntBetween(1, 100),
 						},
 
 						// TODO: remove this field in the next major version
@@ -309,6 +313,63 @@ func resourceArmKubernetesCluster() *schema.Resource {
 				},
 			},
 
+			"role_based_access_control": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+							ForceNew: true,
+						},
+
+						"azure_active_directory": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_app_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateUUID,
+									},
+
+									"server_app_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validateUUID,
+									},
+
+									"server_app_secret": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										Sensitive:    true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"tenant_id": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ForceNew:     true,
+										ValidateFunc: validateUUID,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"addon_profile": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -383,7 +444,21 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 	networkProfile := expandAzureRmKubernetesClusterNetworkProfile(d)
 	addonProfiles := expandAzureRmKubernetesClusterAddonProfiles(d)
 
-	tags := d.Get("tags").(map[string]interface{})
+	rbacEnabled, aadProfile, err := expandAzureRmKubernetesClusterRoleBasedAccessControl(d, client.tenantId)
+	if err != nil {
+		return err
+	}
+
+	agentPool := d.Get("agent_pool_profile.0").(map[string]interface{})
+	agentPoolName := agentPool["name"].(string)
+	autoScalingEnabled := agentPool["enable_auto_scaling"].(bool)
+	minCount := agentPool["min_count"].(int)
+	maxCount := agentPool["max_count"].(int)
+	if err := validateKubernetesClusterAgentPoolAutoScaling(autoScalingEnabled, minCount, maxCount); err != nil {
+		return fmt.Errorf("Error validating `agent_pool_profile` %q: %+v", agentPoolName, err)
+	}
+
+	tags := mergeDefaultTags(meta, d.Get("tags").(map[string]interface{}))
 
 	// we can't do this in the CustomizeDiff since the interpolations aren't evaluated at that point
 	if networkProfile != nil {
@@ -397,6 +472,35 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	azureRMLockByName(name, kubernetesClusterResourceName)
+	defer azureRMUnlockByName(name, kubernetesClusterResourceName)
+
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
+
+	if !d.IsNewResource() {
+		existing, err := kubernetesClustersClient.Get(ctx, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving AKS Managed Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		// Agent Pools managed via the `azurerm_kubernetes_cluster_node_pool` resource are intentionally omitted, since the
+		// version of the API in use doesn't support Auto Scaling (and so would disable it) - Agent Pools which aren't
+		// specified are left as-is
+		if props := existing.ManagedClusterProperties; props != nil && props.AgentPoolProfiles != nil {
+			for _, existingProfile := range *props.AgentPoolProfiles {
+				if existingProfile.Name == nil || *existingProfile.Name != agentPoolName {
+					continue
+				}
+
+				// the Count of an Auto Scaled Agent Pool is managed by the Cluster Autoscaler
+				if autoScalingEnabled && existingProfile.Count != nil {
+					agentProfiles[0].Count = existingProfile.Count
+				}
+			}
+		}
+	}
+
 	parameters := containerservice.ManagedCluster{
 		Name:     &name,
 		Location: &location,
@@ -404,6 +508,8 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 			AddonProfiles:           addonProfiles,
 			AgentPoolProfiles:       &agentProfiles,
 			DNSPrefix:               &dnsPrefix,
+			EnableRBAC:              utils.Bool(rbacEnabled),
+			AadProfile:              aadProfile,
 			KubernetesVersion:       &kubernetesVersion,
 			LinuxProfile:            linuxProfile,
 			ServicePrincipalProfile: servicePrincipalProfile,
@@ -412,7 +518,6 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 		Tags: expandTags(tags),
 	}
 
-	ctx := client.StopContext
 	future, err := kubernetesClustersClient.CreateOrUpdate(ctx, resGroup, name, parameters)
 	if err != nil {
 		return err
@@ -423,6 +528,36 @@ func resourceArmKubernetesClusterCreate(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	// Auto Scaling can only be configured using the Agent Pools API
+	if autoScalingEnabled || d.HasChange("agent_pool_profile.0.enable_auto_scaling") {
+		agentPoolsClient := client.kubernetesAgentPoolsClient
+		agentPoolParameters := agentPools.AgentPool{
+			Name: utils.String(agentPoolName),
+			ManagedClusterAgentPoolProfileProperties: &agentPools.ManagedClusterAgentPoolProfileProperties{
+				Count:             agentProfiles[0].Count,
+				VMSize:            agentPools.VMSizeTypes(agentProfiles[0].VMSize),
+				OsDiskSizeGB:      agentProfiles[0].OsDiskSizeGB,
+				VnetSubnetID:      agentProfiles[0].VnetSubnetID,
+				MaxPods:           agentProfiles[0].MaxPods,
+				OsType:            agentPools.OSType(agentProfiles[0].OsType),
+				EnableAutoScaling: utils.Bool(autoScalingEnabled),
+			},
+		}
+		if autoScalingEnabled {
+			agentPoolParameters.ManagedClusterAgentPoolProfileProperties.MinCount = utils.Int32(int32(minCount))
+			agentPoolParameters.ManagedClusterAgentPoolProfileProperties.MaxCount = utils.Int32(int32(maxCount))
+		}
+
+		agentPoolFuture, err := agentPoolsClient.CreateOrUpdate(ctx, resGroup, name, agentPoolName, agentPoolParameters)
+		if err != nil {
+			return fmt.Errorf("Error configuring Auto Scaling for Agent Pool %q (AKS Managed Cluster %q / Resource Group %q): %+v", agentPoolName, name, resGroup, err)
+		}
+
+		if err := agentPoolFuture.WaitForCompletionRef(ctx, agentPoolsClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for Auto Scaling to be configured for Agent Pool %q (AKS Managed Cluster %q / Resource Group %q): %+v", agentPoolName, name, resGroup, err)
+		}
+	}
+
 	read, err := kubernetesClustersClient.Get(ctx, resGroup, name)
 	if err != nil {
 		return err
@@ -448,7 +583,8 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 	resGroup := id.ResourceGroup
 	name := id.Path["managedClusters"]
 
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 	resp, err := kubernetesClustersClient.Get(ctx, resGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -464,6 +600,16 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error getting access profile while making Read request on AKS Managed Cluster %q (resource group %q): %+v", name, resGroup, err)
 	}
 
+	adminProfile, err := kubernetesClustersClient.GetAccessProfile(ctx, resGroup, name, "clusterAdmin")
+	if err != nil {
+		// the admin credentials require additional permissions, which the credentials in use may not have
+		if !utils.ResponseWasForbidden(adminProfile.Response) {
+			return fmt.Errorf("Error getting admin access profile while making Read request on AKS Managed Cluster %q (resource group %q): %+v", name, resGroup, err)
+		}
+
+		log.Printf("[DEBUG] Not authorized to retrieve the admin access profile for AKS Managed Cluster %q (resource group %q) - `kube_admin_config` will be empty: %+v", name, resGroup, err)
+	}
+
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resGroup)
 	if location := resp.Location; location != nil {
@@ -486,7 +632,23 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `addon_profile`: %+v", err)
 		}
 
-		agentPoolProfiles := flattenAzureRmKubernetesClusterAgentPoolProfiles(props.AgentPoolProfiles, resp.Fqdn)
+		// any other Agent Pools are managed via the `azurerm_kubernetes_cluster_node_pool` resource
+		agentPoolName := d.Get("agent_pool_profile.0.name").(string)
+		agentPoolProfiles := flattenAzureRmKubernetesClusterAgentPoolProfiles(props.AgentPoolProfiles, resp.Fqdn, agentPoolName)
+		for _, v := range agentPoolProfiles {
+			agentPoolProfile := v.(map[string]interface{})
+			agentPool, err := client.kubernetesAgentPoolsClient.Get(ctx, resGroup, name, agentPoolProfile["name"].(string))
+			if err != nil {
+				// Agent Pools which aren't backed by a Virtual Machine Scale Set can't be retrieved individually
+				if utils.ResponseWasNotFound(agentPool.Response) {
+					continue
+				}
+
+				return fmt.Errorf("Error retrieving Agent Pool %q (AKS Managed Cluster %q / Resource Group %q): %+v", agentPoolProfile["name"], name, resGroup, err)
+			}
+
+			flattenAzureRmKubernetesClusterAgentPoolAutoScaling(agentPoolProfile, agentPool.ManagedClusterAgentPoolProfileProperties)
+		}
 		if err := d.Set("agent_pool_profile", agentPoolProfiles); err != nil {
 			return fmt.Errorf("Error setting `agent_pool_profile`: %+v", err)
 		}
@@ -496,6 +658,11 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `network_profile`: %+v", err)
 		}
 
+		roleBasedAccessControl := flattenAzureRmKubernetesClusterRoleBasedAccessControl(props, d)
+		if err := d.Set("role_based_access_control", roleBasedAccessControl); err != nil {
+			return fmt.Errorf("Error setting `role_based_access_control`: %+v", err)
+		}
+
 		servicePrincipal := flattenAzureRmKubernetesClusterServicePrincipalProfile(resp.ManagedClusterProperties.ServicePrincipalProfile)
 		if err := d.Set("service_principal", servicePrincipal); err != nil {
 			return fmt.Errorf("Error setting `service_principal`: %+v", err)
@@ -509,7 +676,14 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error setting `kube_config`: %+v", err)
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	kubeAdminConfigRaw, kubeAdminConfig := flattenAzureRmKubernetesClusterAccessProfile(&adminProfile)
+	d.Set("kube_admin_config_raw", kubeAdminConfigRaw)
+
+	if err := d.Set("kube_admin_config", kubeAdminConfig); err != nil {
+		return fmt.Errorf("Error setting `kube_admin_config`: %+v", err)
+	}
+
+	flattenAndSetResourceTags(d, meta, resp.Tags)
 
 	return nil
 }
@@ -525,7 +699,8 @@ func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}
 	resGroup := id.ResourceGroup
 	name := id.Path["managedClusters"]
 
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
 	future, err := kubernetesClustersClient.Delete(ctx, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error issuing AzureRM delete request of AKS Managed Cluster %q (resource Group %q): %+v", name, resGroup, err)
@@ -563,7 +738,9 @@ func flattenAzureRmKubernetesClusterLinuxProfile(profile *containerservice.Linux
 	return []interface{}{values}
 }
 
-func flattenAzureRmKubernetesClusterAgentPoolProfiles(profiles *[]containerservice.ManagedClusterAgentPoolProfile, fqdn *string) []interface{} {
+// flattenAzureRmKubernetesClusterAgentPoolProfiles flattens the Agent Pool with the specified name - falling back
+// to the first Agent Pool when no name is specified (e.g. when importing)
+func flattenAzureRmKubernetesClusterAgentPoolProfiles(profiles *[]containerservice.ManagedClusterAgentPoolProfile, fqdn *string, name string) []interface{} {
 	if profiles == nil {
 		return []interface{}{}
 	}
@@ -571,6 +748,10 @@ func flattenAzureRmKubernetesClusterAgentPoolProfiles(profiles *[]containerservi
 	agentPoolProfiles := make([]interface{}, 0)
 
 	for _, profile := range *profiles {
+		if name != "" && (profile.Name == nil || *profile.Name != name) {
+			continue
+		}
+
 		agentPoolProfile := make(map[string]interface{})
 
 		if profile.Count != nil {
@@ -606,12 +787,71 @@ func flattenAzureRmKubernetesClusterAgentPoolProfiles(profiles *[]containerservi
 			agentPoolProfile["max_pods"] = int(*profile.MaxPods)
 		}
 
+		agentPoolProfile["enable_auto_scaling"] = false
+		agentPoolProfile["min_count"] = 0
+		agentPoolProfile["max_count"] = 0
+
 		agentPoolProfiles = append(agentPoolProfiles, agentPoolProfile)
+		break
 	}
 
 	return agentPoolProfiles
 }
 
+func flattenAzureRmKubernetesClusterAgentPoolAutoScaling(agentPoolProfile map[string]interface{}, props *agentPools.ManagedClusterAgentPoolProfileProperties) {
+	if props == nil {
+		return
+	}
+
+	if props.EnableAutoScaling != nil {
+		agentPoolProfile["enable_auto_scaling"] = *props.EnableAutoScaling
+	}
+
+	if props.MinCount != nil {
+		agentPoolProfile["min_count"] = int(*props.MinCount)
+	}
+
+	if props.MaxCount != nil {
+		agentPoolProfile["max_count"] = int(*props.MaxCount)
+	}
+}
+
+func flattenAzureRmKubernetesClusterRoleBasedAccessControl(props *containerservice.ManagedClusterProperties, d *schema.ResourceData) []interface{} {
+	enabled := false
+	if props.EnableRBAC != nil {
+		enabled = *props.EnableRBAC
+	}
+
+	azureActiveDirectory := make([]interface{}, 0)
+	if profile := props.AadProfile; profile != nil {
+		values := make(map[string]interface{})
+
+		if profile.ClientAppID != nil {
+			values["client_app_id"] = *profile.ClientAppID
+		}
+
+		if profile.ServerAppID != nil {
+			values["server_app_id"] = *profile.ServerAppID
+		}
+
+		// the Server App Secret isn't returned by the API
+		values["server_app_secret"] = d.Get("role_based_access_control.0.azure_active_directory.0.server_app_secret").(string)
+
+		if profile.TenantID != nil {
+			values["tenant_id"] = *profile.TenantID
+		}
+
+		azureActiveDirectory = append(azureActiveDirectory, values)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":                enabled,
+			"azure_active_directory": azureActiveDirectory,
+		},
+	}
+}
+
 func flattenAzureRmKubernetesClusterServicePrincipalProfile(profile *containerservice.ManagedClusterServicePrincipalProfile) *schema.Set {
 	if profile == nil {
 		return nil
@@ -695,10 +935,136 @@ func flattenKubernetesClusterKubeConfig(config kubernetes.KubeConfig) []interfac
 	values["client_certificate"] = user.ClientCertificteData
 	values["client_key"] = user.ClientKeyData
 	values["cluster_ca_certificate"] = cluster.ClusterAuthorityData
+	values["auth_provider"] = flattenKubernetesClusterKubeConfigAuthProvider(config)
+	values["exec"] = flattenKubernetesClusterKubeConfigExec(config)
+
+	return []interface{}{values}
+}
+
+func flattenKubernetesClusterKubeConfigAuthProvider(config kubernetes.KubeConfig) []interface{} {
+	authProvider := config.Users[0].User.AuthProvider
+	if authProvider == nil {
+		return []interface{}{}
+	}
+
+	values := make(map[string]interface{})
+	values["name"] = authProvider.Name
+
+	providerConfig := make(map[string]interface{})
+	for k, v := range authProvider.Config {
+		providerConfig[k] = v
+	}
+	values["config"] = providerConfig
+
+	return []interface{}{values}
+}
+
+func flattenKubernetesClusterKubeConfigExec(config kubernetes.KubeConfig) []interface{} {
+	exec := config.Users[0].User.Exec
+	if exec == nil {
+		return []interface{}{}
+	}
+
+	values := make(map[string]interface{})
+	values["api_version"] = exec.APIVersion
+	values["command"] = exec.Command
+
+	args := make([]interface{}, 0)
+	for _, arg := range exec.Args {
+		args = append(args, arg)
+	}
+	values["args"] = args
+
+	env := make(map[string]interface{})
+	for _, v := range exec.Env {
+		env[v.Name] = v.Value
+	}
+	values["env"] = env
 
 	return []interface{}{values}
 }
 
+// kubernetesClusterKubeConfigSchema returns the schema for the credentials within a kubeconfig, which is
+// shared between the `kube_config` and `kube_admin_config` blocks of both the Resource and the Data Source
+func kubernetesClusterKubeConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"username": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"password": {
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+				"client_certificate": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"client_key": {
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+				"cluster_ca_certificate": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"auth_provider": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"name": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"config": {
+								Type:      schema.TypeMap,
+								Computed:  true,
+								Sensitive: true,
+							},
+						},
+					},
+				},
+				"exec": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"api_version": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"command": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"args": {
+								Type:     schema.TypeList,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"env": {
+								Type:     schema.TypeMap,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func expandAzureRmKubernetesClusterLinuxProfile(d *schema.ResourceData) *containerservice.LinuxProfile {
 	profiles := d.Get("linux_profile").([]interface{})
 
@@ -786,6 +1152,41 @@ func expandAzureRmKubernetesClusterAgentProfiles(d *schema.ResourceData) []conta
 	return profiles
 }
 
+func expandAzureRmKubernetesClusterRoleBasedAccessControl(d *schema.ResourceData, providerTenantId string) (bool, *containerservice.ManagedClusterAADProfile, error) {
+	configs := d.Get("role_based_access_control").([]interface{})
+	if len(configs) == 0 {
+		return false, nil, nil
+	}
+
+	config := configs[0].(map[string]interface{})
+	enabled := config["enabled"].(bool)
+
+	azureActiveDirectory := config["azure_active_directory"].([]interface{})
+	if len(azureActiveDirectory) == 0 {
+		return enabled, nil, nil
+	}
+
+	if !enabled {
+		return false, nil, fmt.Errorf("Role Based Access Control must be enabled to use an `azure_active_directory` block")
+	}
+
+	value := azureActiveDirectory[0].(map[string]interface{})
+
+	tenantId := value["tenant_id"].(string)
+	if tenantId == "" {
+		tenantId = providerTenantId
+	}
+
+	profile := containerservice.ManagedClusterAADProfile{
+		ClientAppID:     utils.String(value["client_app_id"].(string)),
+		ServerAppID:     utils.String(value["server_app_id"].(string)),
+		ServerAppSecret: utils.String(value["server_app_secret"].(string)),
+		TenantID:        utils.String(tenantId),
+	}
+
+	return true, &profile, nil
+}
+
 func expandAzureRmKubernetesClusterNetworkProfile(d *schema.ResourceData) *containerservice.NetworkProfile {
 	configs := d.Get("network_profile").([]interface{})
 	if len(configs) == 0 {
@@ -916,6 +1317,36 @@ func resourceAzureRMKubernetesClusterServicePrincipalProfileHash(v interface{})
 	return hashcode.String(buf.String())
 }
 
+// validateKubernetesClusterAgentPoolAutoScaling ensures the `min_count` and `max_count` are only (and both) specified
+// when Auto Scaling is enabled - which can't be done in the schema since these are nested / can be interpolated
+func validateKubernetesClusterAgentPoolAutoScaling(enabled bool, minCount int, maxCount int) error {
+	if !enabled {
+		if minCount != 0 || maxCount != 0 {
+			return fmt.Errorf("`min_count` and `max_count` can only be specified when `enable_auto_scaling` is set to `true`")
+		}
+
+		return nil
+	}
+
+	if minCount == 0 || maxCount == 0 {
+		return fmt.Errorf("`min_count` and `max_count` must be specified when `enable_auto_scaling` is set to `true`")
+	}
+
+	if minCount > maxCount {
+		return fmt.Errorf("`min_count` (%d) must be less than or equal to `max_count` (%d)", minCount, maxCount)
+	}
+
+	return nil
+}
+
+// suppressKubernetesClusterAgentPoolCountWhenAutoScaling ignores changes to the `count` of an existing Agent Pool
+// when Auto Scaling is enabled, since the number of nodes is then managed by the Cluster Autoscaler
+func suppressKubernetesClusterAgentPoolCountWhenAutoScaling(autoScalingKey string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		return old != "" && d.Get(autoScalingKey).(bool)
+	}
+}
+
 func validateKubernetesClusterAgentPoolName() schema.SchemaValidateFunc {
 	return validation.StringMatch(
 		regexp.MustCompile("^[a-z]{1}[a-z0-9]{0,11}$"),
@@ -0,0 +1,310 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-02-01/containerservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKubernetesClusterNodePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKubernetesClusterNodePoolCreateUpdate,
+		Read:   resourceArmKubernetesClusterNodePoolRead,
+		Update: resourceArmKubernetesClusterNodePoolCreateUpdate,
+		Delete: resourceArmKubernetesClusterNodePoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKubernetesClusterAgentPoolName(),
+			},
+
+			"kubernetes_cluster_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"vm_size": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"node_count": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateFunc:     validation.IntBetween(1, 100),
+				DiffSuppressFunc: suppressKubernetesClusterAgentPoolCountWhenAutoScaling("enable_auto_scaling"),
+			},
+
+			"enable_auto_scaling": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"min_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"max_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"os_disk_size_gb": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"os_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(containerservice.Linux),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(containerservice.Linux),
+					string(containerservice.Windows),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"vnet_subnet_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"max_pods": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceArmKubernetesClusterNodePoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kubernetesAgentPoolsClient
+	clustersClient := meta.(*ArmClient).kubernetesClustersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AKS Node Pool creation.")
+
+	name := d.Get("name").(string)
+	clusterId, err := parseAzureResourceID(d.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return err
+	}
+	resGroup := clusterId.ResourceGroup
+	clusterName := clusterId.Path["managedClusters"]
+	if clusterName == "" {
+		return fmt.Errorf("Error parsing `kubernetes_cluster_id`: %q isn't the ID of an AKS Managed Cluster", d.Get("kubernetes_cluster_id").(string))
+	}
+
+	autoScalingEnabled := d.Get("enable_auto_scaling").(bool)
+	minCount := d.Get("min_count").(int)
+	maxCount := d.Get("max_count").(int)
+	if err := validateKubernetesClusterAgentPoolAutoScaling(autoScalingEnabled, minCount, maxCount); err != nil {
+		return fmt.Errorf("Error validating AKS Node Pool %q: %+v", name, err)
+	}
+
+	azureRMLockByName(clusterName, kubernetesClusterResourceName)
+	defer azureRMUnlockByName(clusterName, kubernetesClusterResourceName)
+
+	cluster, err := clustersClient.Get(ctx, resGroup, clusterName)
+	if err != nil {
+		if utils.ResponseWasNotFound(cluster.Response) {
+			return fmt.Errorf("AKS Managed Cluster %q (Resource Group %q) was not found", clusterName, resGroup)
+		}
+
+		return fmt.Errorf("Error retrieving AKS Managed Cluster %q (Resource Group %q): %+v", clusterName, resGroup, err)
+	}
+
+	props := containerservice.ManagedClusterAgentPoolProfileProperties{
+		Count:             utils.Int32(int32(d.Get("node_count").(int))),
+		VMSize:            containerservice.VMSizeTypes(d.Get("vm_size").(string)),
+		OsType:            containerservice.OSType(d.Get("os_type").(string)),
+		EnableAutoScaling: utils.Bool(autoScalingEnabled),
+	}
+
+	if autoScalingEnabled {
+		props.MinCount = utils.Int32(int32(minCount))
+		props.MaxCount = utils.Int32(int32(maxCount))
+
+		// the Count of an existing Node Pool is managed by the Cluster Autoscaler
+		if !d.IsNewResource() {
+			existing, err := client.Get(ctx, resGroup, clusterName, name)
+			if err != nil {
+				return fmt.Errorf("Error retrieving AKS Node Pool %q (Managed Cluster %q / Resource Group %q): %+v", name, clusterName, resGroup, err)
+			}
+
+			if existing.ManagedClusterAgentPoolProfileProperties != nil && existing.ManagedClusterAgentPoolProfileProperties.Count != nil {
+				props.Count = existing.ManagedClusterAgentPoolProfileProperties.Count
+			}
+		}
+	}
+
+	if v := d.Get("os_disk_size_gb").(int); v > 0 {
+		props.OsDiskSizeGB = utils.Int32(int32(v))
+	}
+
+	if v := d.Get("vnet_subnet_id").(string); v != "" {
+		props.VnetSubnetID = utils.String(v)
+	}
+
+	if v := d.Get("max_pods").(int); v > 0 {
+		props.MaxPods = utils.Int32(int32(v))
+	}
+
+	parameters := containerservice.AgentPool{
+		Name:                                     utils.String(name),
+		ManagedClusterAgentPoolProfileProperties: &props,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, clusterName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating AKS Node Pool %q (Managed Cluster %q / Resource Group %q): %+v", name, clusterName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of AKS Node Pool %q (Managed Cluster %q / Resource Group %q): %+v", name, clusterName, resGroup, err)
+	}
+
+	read, err := client.Get(ctx, resGroup, clusterName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving AKS Node Pool %q (Managed Cluster %q / Resource Group %q): %+v", name, clusterName, resGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read AKS Node Pool %q (Managed Cluster %q / Resource Group %q) ID", name, clusterName, resGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKubernetesClusterNodePoolRead(d, meta)
+}
+
+func resourceArmKubernetesClusterNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kubernetesAgentPoolsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	clusterName := id.Path["managedClusters"]
+	name := id.Path["agentPools"]
+
+	resp, err := client.Get(ctx, resGroup, clusterName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] AKS Node Pool %q was not found in Managed Cluster %q (Resource Group %q) - removing from state!", name, clusterName, resGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on AKS Node Pool %q (Managed Cluster %q / Resource Group %q): %+v", name, clusterName, resGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("kubernetes_cluster_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s", id.SubscriptionID, resGroup, clusterName))
+
+	if props := resp.ManagedClusterAgentPoolProfileProperties; props != nil {
+		if props.Count != nil {
+			d.Set("node_count", int(*props.Count))
+		}
+		d.Set("vm_size", string(props.VMSize))
+		d.Set("os_type", string(props.OsType))
+
+		enableAutoScaling := false
+		if props.EnableAutoScaling != nil {
+			enableAutoScaling = *props.EnableAutoScaling
+		}
+		d.Set("enable_auto_scaling", enableAutoScaling)
+
+		minCount := 0
+		if props.MinCount != nil {
+			minCount = int(*props.MinCount)
+		}
+		d.Set("min_count", minCount)
+
+		maxCount := 0
+		if props.MaxCount != nil {
+			maxCount = int(*props.MaxCount)
+		}
+		d.Set("max_count", maxCount)
+
+		if props.OsDiskSizeGB != nil {
+			d.Set("os_disk_size_gb", int(*props.OsDiskSizeGB))
+		}
+
+		if props.MaxPods != nil {
+			d.Set("max_pods", int(*props.MaxPods))
+		}
+
+		d.Set("vnet_subnet_id", props.VnetSubnetID)
+	}
+
+	return nil
+}
+
+func resourceArmKubernetesClusterNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kubernetesAgentPoolsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	clusterName := id.Path["managedClusters"]
+	name := id.Path["agentPools"]
+
+	azureRMLockByName(clusterName, kubernetesClusterResourceName)
+	defer azureRMUnlockByName(clusterName, kubernetesClusterResourceName)
+
+	future, err := client.Delete(ctx, resGroup, clusterName, name)
+	if err != nil {
+		return fmt.Errorf("Error deleting AKS Node Pool %q (Managed Cluster %q / Resource Group %q): %+v", name, clusterName, resGroup, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of AKS Node Pool %q (Managed Cluster %q / Resource Group %q): %+v", name, clusterName, resGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,268 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+)
+
+func TestUnitAzureRMKubernetesClusterNodePool_autoScaling(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster_node_pool.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	// the Node Pool is managed independently of the Managed Cluster - which is stubbed rather than provisioned
+	clusterId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.ContainerService/managedClusters/acctestaks%d", fakearm.SubscriptionID, ri, ri)
+	server.Put(clusterId, map[string]interface{}{
+		"location": location,
+		"properties": map[string]interface{}{
+			"dnsPrefix": fmt.Sprintf("acctestaks%d", ri),
+		},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_fakeCluster(ri, location, testAccAzureRMKubernetesClusterNodePool_manualScaling()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "internal"),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_scaling", "false"),
+					resource.TestCheckResourceAttr(resourceName, "os_type", "Linux"),
+				),
+			},
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_fakeCluster(ri, location, testAccAzureRMKubernetesClusterNodePool_autoScaling()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_scaling", "true"),
+					resource.TestCheckResourceAttr(resourceName, "min_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_count", "5"),
+				),
+			},
+			{
+				// the Cluster Autoscaler has scaled the Node Pool, which shouldn't be reverted
+				PreConfig: func() {
+					id := clusterId + "/agentPools/internal"
+					agentPool, _ := server.Get(id)
+					agentPool["properties"].(map[string]interface{})["count"] = 4
+					server.Put(id, agentPool)
+				},
+				Config:   testAccAzureRMKubernetesClusterNodePool_fakeCluster(ri, location, testAccAzureRMKubernetesClusterNodePool_autoScaling()),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesClusterNodePool_basic(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster_node_pool.test"
+	ri := acctest.RandInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_cluster(ri, clientId, clientSecret, location, testAccAzureRMKubernetesClusterNodePool_manualScaling()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "node_count", "2"),
+					resource.TestCheckResourceAttr("azurerm_kubernetes_cluster.test", "agent_pool_profile.#", "1"),
+					resource.TestCheckResourceAttr("azurerm_kubernetes_cluster.test", "agent_pool_profile.0.name", "default"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesClusterNodePool_autoScaling(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster_node_pool.test"
+	ri := acctest.RandInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterNodePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_cluster(ri, clientId, clientSecret, location, testAccAzureRMKubernetesClusterNodePool_manualScaling()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_scaling", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMKubernetesClusterNodePool_cluster(ri, clientId, clientSecret, location, testAccAzureRMKubernetesClusterNodePool_autoScaling()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterNodePoolExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enable_auto_scaling", "true"),
+					resource.TestCheckResourceAttr(resourceName, "min_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "max_count", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMKubernetesClusterNodePoolExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		clusterName := id.Path["managedClusters"]
+		nodePoolName := id.Path["agentPools"]
+
+		client := testAccProvider.Meta().(*ArmClient).kubernetesAgentPoolsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, clusterName, nodePoolName)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: AKS Node Pool %q (Managed Cluster %q / Resource Group %q) does not exist", nodePoolName, clusterName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on kubernetesAgentPoolsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKubernetesClusterNodePoolDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).kubernetesAgentPoolsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_kubernetes_cluster_node_pool" {
+			continue
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Path["managedClusters"], id.Path["agentPools"])
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("AKS Node Pool still exists:\n%#v", resp)
+	}
+
+	return testCheckAzureRMKubernetesClusterDestroy(s)
+}
+
+func testAccAzureRMKubernetesClusterNodePool_manualScaling() string {
+	return `
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = "${local.kubernetes_cluster_id}"
+  vm_size               = "Standard_DS2_v2"
+  node_count            = 2
+}
+`
+}
+
+func testAccAzureRMKubernetesClusterNodePool_autoScaling() string {
+	return `
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = "${local.kubernetes_cluster_id}"
+  vm_size               = "Standard_DS2_v2"
+  node_count            = 2
+  enable_auto_scaling   = true
+  min_count             = 1
+  max_count             = 5
+}
+`
+}
+
+func testAccAzureRMKubernetesClusterNodePool_fakeCluster(rInt int, location string, nodePool string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+locals {
+  kubernetes_cluster_id = "${azurerm_resource_group.test.id}/providers/Microsoft.ContainerService/managedClusters/acctestaks%d"
+}
+
+%s
+`, rInt, location, rInt, nodePool)
+}
+
+func testAccAzureRMKubernetesClusterNodePool_cluster(rInt int, clientId string, clientSecret string, location string, nodePool string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+
+  agent_pool_profile {
+    name    = "default"
+    count   = "1"
+    vm_size = "Standard_DS2_v2"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+}
+
+locals {
+  kubernetes_cluster_id = "${azurerm_kubernetes_cluster.test.id}"
+}
+
+%s
+`, rInt, location, rInt, rInt, clientId, clientSecret, nodePool)
+}
@@ -1,14 +1,19 @@
 package azurerm
 
 import (
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/kubernetes"
 )
 
 func TestAzureRMKubernetesCluster_agentPoolName(t *testing.T) {
@@ -69,6 +74,267 @@ func TestAzureRMKubernetesCluster_agentPoolName(t *testing.T) {
 	}
 }
 
+func TestAzureRMKubernetesCluster_agentPoolAutoScaling(t *testing.T) {
+	cases := []struct {
+		Enabled     bool
+		MinCount    int
+		MaxCount    int
+		ExpectError bool
+	}{
+		{
+			Enabled:     false,
+			ExpectError: false,
+		},
+		{
+			Enabled:     false,
+			MinCount:    1,
+			ExpectError: true,
+		},
+		{
+			Enabled:     false,
+			MaxCount:    3,
+			ExpectError: true,
+		},
+		{
+			Enabled:     true,
+			ExpectError: true,
+		},
+		{
+			Enabled:     true,
+			MinCount:    1,
+			ExpectError: true,
+		},
+		{
+			Enabled:     true,
+			MinCount:    1,
+			MaxCount:    3,
+			ExpectError: false,
+		},
+		{
+			Enabled:     true,
+			MinCount:    3,
+			MaxCount:    3,
+			ExpectError: false,
+		},
+		{
+			Enabled:     true,
+			MinCount:    5,
+			MaxCount:    3,
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateKubernetesClusterAgentPoolAutoScaling(tc.Enabled, tc.MinCount, tc.MaxCount)
+
+		hasError := err != nil
+		if tc.ExpectError != hasError {
+			t.Fatalf("Expected an error to be %t for Auto Scaling %t (Min %d / Max %d) but got: %+v", tc.ExpectError, tc.Enabled, tc.MinCount, tc.MaxCount, err)
+		}
+	}
+}
+
+func TestFlattenKubernetesClusterKubeConfig(t *testing.T) {
+	authProviderConfig := `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: test-cluster-authority-data
+    server: https://testcluster.org:443
+  name: test-cluster
+kind: Config
+users:
+- name: clusterUser_test-group_test-cluster
+  user:
+    auth-provider:
+      config:
+        apiserver-id: 00000000-0000-0000-0000-000000000001
+        client-id: 00000000-0000-0000-0000-000000000002
+      name: azure
+`
+	execConfig := `apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: test-cluster-authority-data
+    server: https://testcluster.org:443
+  name: test-cluster
+kind: Config
+users:
+- name: test-user
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: kubelogin
+      args:
+      - get-token
+      env:
+      - name: AAD_LOGIN_METHOD
+        value: spn
+`
+
+	cases := []struct {
+		Config   string
+		Expected map[string]interface{}
+	}{
+		{
+			Config: authProviderConfig,
+			Expected: map[string]interface{}{
+				"host":                   "https://testcluster.org:443",
+				"username":               "clusterUser_test-group_test-cluster",
+				"password":               "",
+				"client_certificate":     "",
+				"client_key":             "",
+				"cluster_ca_certificate": "test-cluster-authority-data",
+				"auth_provider": []interface{}{
+					map[string]interface{}{
+						"name": "azure",
+						"config": map[string]interface{}{
+							"apiserver-id": "00000000-0000-0000-0000-000000000001",
+							"client-id":    "00000000-0000-0000-0000-000000000002",
+						},
+					},
+				},
+				"exec": []interface{}{},
+			},
+		},
+		{
+			Config: execConfig,
+			Expected: map[string]interface{}{
+				"host":                   "https://testcluster.org:443",
+				"username":               "test-user",
+				"password":               "",
+				"client_certificate":     "",
+				"client_key":             "",
+				"cluster_ca_certificate": "test-cluster-authority-data",
+				"auth_provider":          []interface{}{},
+				"exec": []interface{}{
+					map[string]interface{}{
+						"api_version": "client.authentication.k8s.io/v1beta1",
+						"command":     "kubelogin",
+						"args":        []interface{}{"get-token"},
+						"env": map[string]interface{}{
+							"AAD_LOGIN_METHOD": "spn",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		config, err := kubernetes.ParseKubeConfig(tc.Config)
+		if err != nil {
+			t.Fatalf("Case %d: Error parsing the kubeconfig: %+v", i, err)
+		}
+
+		actual := flattenKubernetesClusterKubeConfig(*config)
+		if !reflect.DeepEqual(actual, []interface{}{tc.Expected}) {
+			t.Fatalf("Case %d: Expected %+v but got %+v", i, tc.Expected, actual)
+		}
+	}
+}
+
+func TestUnitAzureRMKubernetesCluster_nodePoolsRetained(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	clusterId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.ContainerService/managedClusters/acctestaks%d", fakearm.SubscriptionID, ri, ri)
+	nodePoolId := clusterId + "/agentPools/internal"
+
+	testFakeKubernetesClusterAPI(server, true)
+
+	// the names of the Agent Pools sent in the last request to create/update the Managed Cluster
+	var agentPoolNames []string
+	server.SideEffect("Microsoft.ContainerService/managedClusters", func(cluster map[string]interface{}, put func(id string, resource map[string]interface{})) {
+		agentPoolNames = make([]string, 0)
+		profiles, _ := cluster["properties"].(map[string]interface{})["agentPoolProfiles"].([]interface{})
+		for _, v := range profiles {
+			agentPoolNames = append(agentPoolNames, v.(map[string]interface{})["name"].(string))
+		}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesCluster_basic(ri, "00000000-0000-0000-0000-000000000000", "secret", location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "agent_pool_profile.#", "1"),
+				),
+			},
+			{
+				// an Auto Scaled Agent Pool managed via the `azurerm_kubernetes_cluster_node_pool` resource
+				PreConfig: func() {
+					server.Put(nodePoolId, map[string]interface{}{
+						"properties": map[string]interface{}{
+							"count":             3,
+							"vmSize":            "Standard_DS2_v2",
+							"enableAutoScaling": true,
+							"minCount":          1,
+							"maxCount":          5,
+						},
+					})
+
+					cluster, _ := server.Get(clusterId)
+					props := cluster["properties"].(map[string]interface{})
+					props["agentPoolProfiles"] = append(props["agentPoolProfiles"].([]interface{}), map[string]interface{}{
+						"name":   "internal",
+						"count":  3,
+						"vmSize": "Standard_DS2_v2",
+					})
+					server.Put(clusterId, cluster)
+				},
+				Config: testAccAzureRMKubernetesCluster_autoScaling(ri, "00000000-0000-0000-0000-000000000000", "secret", location, 1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "agent_pool_profile.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "agent_pool_profile.0.enable_auto_scaling", "true"),
+					func(s *terraform.State) error {
+						if !reflect.DeepEqual(agentPoolNames, []string{"default"}) {
+							return fmt.Errorf("Expected only the `default` Agent Pool to be sent when updating the Managed Cluster but got %+v", agentPoolNames)
+						}
+
+						nodePool, _ := server.Get(nodePoolId)
+						if props := nodePool["properties"].(map[string]interface{}); props["enableAutoScaling"] != true || props["maxCount"] != float64(5) {
+							return fmt.Errorf("Expected the Auto Scaling settings of the `internal` Agent Pool to be retained but got %+v", props)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitAzureRMKubernetesCluster_adminCredentialsForbidden(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster.test"
+	ri := acctest.RandInt()
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	testFakeKubernetesClusterAPI(server, false)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesCluster_basic(ri, "00000000-0000-0000-0000-000000000000", "secret", "westeurope"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "kube_config_raw", "apiVersion: v1"),
+					resource.TestCheckResourceAttr(resourceName, "kube_admin_config_raw", ""),
+					resource.TestCheckResourceAttr(resourceName, "kube_admin_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMKubernetesCluster_basic(t *testing.T) {
 	resourceName := "azurerm_kubernetes_cluster.test"
 	ri := acctest.RandInt()
@@ -91,6 +357,10 @@ func TestAccAzureRMKubernetesCluster_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "kube_config.0.host"),
 					resource.TestCheckResourceAttrSet(resourceName, "kube_config.0.username"),
 					resource.TestCheckResourceAttrSet(resourceName, "kube_config.0.password"),
+					resource.TestCheckResourceAttrSet(resourceName, "kube_admin_config.0.client_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "kube_admin_config.0.client_certificate"),
+					resource.TestCheckResourceAttrSet(resourceName, "kube_admin_config.0.host"),
+					resource.TestCheckResourceAttrSet(resourceName, "kube_admin_config_raw"),
 					resource.TestCheckResourceAttrSet(resourceName, "agent_pool_profile.0.max_pods"),
 				),
 			},
@@ -187,6 +457,97 @@ func TestAccAzureRMKubernetesCluster_upgradeConfig(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKubernetesCluster_autoScaling(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster.test"
+	ri := acctest.RandInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKubernetesCluster_autoScaling(ri, clientId, clientSecret, location, 1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "agent_pool_profile.0.enable_auto_scaling", "true"),
+					resource.TestCheckResourceAttr(resourceName, "agent_pool_profile.0.min_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "agent_pool_profile.0.max_count", "3"),
+				),
+			},
+			{
+				Config: testAccAzureRMKubernetesCluster_autoScaling(ri, clientId, clientSecret, location, 2, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "agent_pool_profile.0.min_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "agent_pool_profile.0.max_count", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesCluster_roleBasedAccessControl(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster.test"
+	ri := acctest.RandInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	config := testAccAzureRMKubernetesCluster_roleBasedAccessControl(ri, clientId, clientSecret, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.0.azure_active_directory.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMKubernetesCluster_roleBasedAccessControlAAD(t *testing.T) {
+	resourceName := "azurerm_kubernetes_cluster.test"
+	ri := acctest.RandInt()
+	clientId := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+	tenantId := os.Getenv("ARM_TENANT_ID")
+	config := testAccAzureRMKubernetesCluster_roleBasedAccessControlAAD(ri, clientId, clientSecret, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKubernetesClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKubernetesClusterExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.0.azure_active_directory.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.0.azure_active_directory.0.client_app_id", clientId),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.0.azure_active_directory.0.server_app_id", clientId),
+					resource.TestCheckResourceAttr(resourceName, "role_based_access_control.0.azure_active_directory.0.tenant_id", tenantId),
+					resource.TestCheckResourceAttr(resourceName, "kube_config.0.auth_provider.0.name", "azure"),
+					resource.TestCheckResourceAttr(resourceName, "kube_config.0.auth_provider.0.config.client-id", clientId),
+					resource.TestCheckResourceAttrSet(resourceName, "kube_admin_config.0.client_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "kube_admin_config.0.client_certificate"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMKubernetesCluster_internalNetwork(t *testing.T) {
 	resourceName := "azurerm_kubernetes_cluster.test"
 	ri := acctest.RandInt()
@@ -624,6 +985,104 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, rInt, location, rInt, rInt, rInt, clientId, clientSecret)
 }
 
+func testAccAzureRMKubernetesCluster_autoScaling(rInt int, clientId string, clientSecret string, location string, minCount int, maxCount int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+
+  agent_pool_profile {
+    name                = "default"
+    count               = "%d"
+    vm_size             = "Standard_DS2_v2"
+    enable_auto_scaling = true
+    min_count           = %d
+    max_count           = %d
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+}
+`, rInt, location, rInt, rInt, minCount, minCount, maxCount, clientId, clientSecret)
+}
+
+func testAccAzureRMKubernetesCluster_roleBasedAccessControl(rInt int, clientId string, clientSecret string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+
+  agent_pool_profile {
+    name    = "default"
+    count   = "1"
+    vm_size = "Standard_DS2_v2"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+
+  role_based_access_control {
+    enabled = true
+  }
+}
+`, rInt, location, rInt, rInt, clientId, clientSecret)
+}
+
+func testAccAzureRMKubernetesCluster_roleBasedAccessControlAAD(rInt int, clientId string, clientSecret string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  dns_prefix          = "acctestaks%d"
+
+  agent_pool_profile {
+    name    = "default"
+    count   = "1"
+    vm_size = "Standard_DS2_v2"
+  }
+
+  service_principal {
+    client_id     = "%s"
+    client_secret = "%s"
+  }
+
+  role_based_access_control {
+    enabled = true
+
+    azure_active_directory {
+      client_app_id     = "%s"
+      server_app_id     = "%s"
+      server_app_secret = "%s"
+    }
+  }
+}
+`, rInt, location, rInt, rInt, clientId, clientSecret, clientId, clientId, clientSecret)
+}
+
 func testAccAzureRMKubernetesCluster_advancedNetworking(rInt int, clientId string, clientSecret string, location string, networkPlugin string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -743,6 +1202,37 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, rInt, location, rInt, rInt, rInt, rInt, rInt, clientId, clientSecret, networkPlugin)
 }
 
+// testFakeKubernetesClusterAPI registers the computed fields of a Managed Cluster and the `listCredential` action for
+// its Access Profiles, where the Cluster Admin credentials can only be retrieved when `adminAuthorized` is true
+func testFakeKubernetesClusterAPI(server *fakearm.Server, adminAuthorized bool) {
+	server.Computed("Microsoft.ContainerService/managedClusters", func(cluster map[string]interface{}) {
+		props := cluster["properties"].(map[string]interface{})
+		props["fqdn"] = fmt.Sprintf("%s.hcp.westeurope.azmk8s.io", props["dnsPrefix"])
+		if _, ok := props["networkProfile"]; !ok {
+			props["networkProfile"] = map[string]interface{}{
+				"networkPlugin": "kubenet",
+			}
+		}
+	})
+
+	server.RequestAction("Microsoft.ContainerService/managedClusters/accessProfiles", "listCredential", func(id string, body map[string]interface{}) (int, interface{}) {
+		if strings.HasSuffix(id, "/clusterAdmin") && !adminAuthorized {
+			return http.StatusForbidden, map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    "AuthorizationFailed",
+					"message": "The client does not have authorization to perform action 'Microsoft.ContainerService/managedClusters/accessProfiles/listCredential/action'",
+				},
+			}
+		}
+
+		return http.StatusOK, map[string]interface{}{
+			"properties": map[string]interface{}{
+				"kubeConfig": base64.StdEncoding.EncodeToString([]byte("apiVersion: v1")),
+			},
+		}
+	})
+}
+
 func testCheckAzureRMKubernetesClusterExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
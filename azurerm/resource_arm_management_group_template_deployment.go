@@ -0,0 +1,127 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmManagementGroupTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagementGroupTemplateDeploymentCreate,
+		Read:   resourceArmManagementGroupTemplateDeploymentRead,
+		Update: resourceArmManagementGroupTemplateDeploymentUpdate,
+		Delete: resourceArmManagementGroupTemplateDeploymentDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(180 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"management_group_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateManagementGroupTemplateDeploymentManagementGroupID,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			// the location the Deployment's metadata is stored in
+			"location": locationSchema(),
+
+			"template_body": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: normalizeJson,
+			},
+
+			"parameters": {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameters_body"},
+			},
+
+			"parameters_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				StateFunc:     normalizeJson,
+				ConflictsWith: []string{"parameters"},
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmManagementGroupTemplateDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := templateDeploymentAtScopeDeploy(d, meta, d.Get("management_group_id").(string)); err != nil {
+		return err
+	}
+
+	return resourceArmManagementGroupTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmManagementGroupTemplateDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	if templateDeploymentAtScopeUpdateRequired(d) {
+		if err := templateDeploymentAtScopeDeploy(d, meta, d.Get("management_group_id").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmManagementGroupTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmManagementGroupTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	scope, err := templateDeploymentAtScopeRead(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if scope != "" {
+		d.Set("management_group_id", scope)
+	}
+
+	return nil
+}
+
+func resourceArmManagementGroupTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	return templateDeploymentAtScopeDelete(d, meta)
+}
+
+func validateManagementGroupTemplateDeploymentManagementGroupID(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// /providers/Microsoft.Management/managementGroups/example
+	segments := strings.Split(v, "/")
+	if len(segments) != 5 || segments[0] != "" || !strings.EqualFold(segments[1], "providers") ||
+		!strings.EqualFold(segments[2], "Microsoft.Management") || !strings.EqualFold(segments[3], "managementGroups") || segments[4] == "" {
+		errors = append(errors, fmt.Errorf("%q must be the ID of a Management Group in the format `/providers/Microsoft.Management/managementGroups/{name}`, got %q", k, v))
+	}
+
+	return
+}
@@ -0,0 +1,146 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+)
+
+func TestValidateManagementGroupTemplateDeploymentManagementGroupID(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "example",
+			Valid: false,
+		},
+		{
+			Input: "/providers/Microsoft.Management/managementGroups/",
+			Valid: false,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Valid: false,
+		},
+		{
+			Input: "/providers/Microsoft.Management/managementGroups/example/subscriptions/00000000-0000-0000-0000-000000000000",
+			Valid: false,
+		},
+		{
+			Input: "/providers/Microsoft.Management/managementGroups/example",
+			Valid: true,
+		},
+		{
+			Input: "/providers/microsoft.management/managementgroups/00000000-0000-0000-0000-000000000000",
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateManagementGroupTemplateDeploymentManagementGroupID(tc.Input, "management_group_id")
+		valid := len(errors) == 0
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t for %q but got %t: %+v", tc.Valid, tc.Input, valid, errors)
+		}
+	}
+}
+
+func TestUnitAzureRMManagementGroupTemplateDeployment_update(t *testing.T) {
+	resourceName := "azurerm_management_group_template_deployment.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+	testFakeTemplateDeploymentOutputs(server)
+
+	managementGroupId := fmt.Sprintf("/providers/Microsoft.Management/managementGroups/acctestmg-%d", ri)
+	deploymentId := fmt.Sprintf("%s/providers/Microsoft.Resources/deployments/acctesttemplate-%d", managementGroupId, ri)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagementGroupTemplateDeployment_outputs(ri, location, fmt.Sprintf("%q", managementGroupId), "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", deploymentId),
+					resource.TestCheckResourceAttr(resourceName, "management_group_id", managementGroupId),
+					resource.TestCheckResourceAttr(resourceName, "location", location),
+					resource.TestCheckResourceAttr(resourceName, "outputs.greeting", "first"),
+				),
+			},
+			{
+				// changing the Template redeploys it in-place
+				Config: testAccAzureRMManagementGroupTemplateDeployment_outputs(ri, location, fmt.Sprintf("%q", managementGroupId), "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", deploymentId),
+					resource.TestCheckResourceAttr(resourceName, "outputs.greeting", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagementGroupTemplateDeployment_basic(t *testing.T) {
+	resourceName := "azurerm_management_group_template_deployment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentAtScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagementGroupTemplateDeployment_managementGroup(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentAtScopeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.greeting", "first"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMManagementGroupTemplateDeployment_outputs(rInt int, location string, managementGroupId string, greeting string) string {
+	return fmt.Sprintf(`
+resource "azurerm_management_group_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  management_group_id = %s
+  location            = "%s"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-08-01/managementGroupDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [],
+  "outputs": {
+    "greeting": {
+      "type": "string",
+      "value": "%s"
+    }
+  }
+}
+DEPLOY
+}
+`, rInt, managementGroupId, location, greeting)
+}
+
+func testAccAzureRMManagementGroupTemplateDeployment_managementGroup(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_management_group" "test" {
+  group_id = "acctestmg-%d"
+}
+
+%s
+`, rInt, testAccAzureRMManagementGroupTemplateDeployment_outputs(rInt, location, `"${azurerm_management_group.test.id}"`, "first"))
+}
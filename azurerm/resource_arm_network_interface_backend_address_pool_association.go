@@ -0,0 +1,285 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkInterfaceBackendAddressPoolAssociationCreate,
+		Read:   resourceArmNetworkInterfaceBackendAddressPoolAssociationRead,
+		Delete: resourceArmNetworkInterfaceBackendAddressPoolAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"ip_configuration_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"backend_address_pool_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+	}
+}
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Network Interface <-> Load Balancer Backend Address Pool Association creation.")
+
+	networkInterfaceId := d.Get("network_interface_id").(string)
+	ipConfigurationName := d.Get("ip_configuration_name").(string)
+	backendAddressPoolId := d.Get("backend_address_pool_id").(string)
+
+	id, err := parseAzureResourceID(networkInterfaceId)
+	if err != nil {
+		return err
+	}
+
+	networkInterfaceName := id.Path["networkInterfaces"]
+	resourceGroup := id.ResourceGroup
+
+	azureRMLockByName(networkInterfaceName, networkInterfaceResourceName)
+	defer azureRMUnlockByName(networkInterfaceName, networkInterfaceResourceName)
+
+	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", networkInterfaceName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	props := read.InterfacePropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+	}
+
+	config := findNetworkInterfaceIPConfiguration(props.IPConfigurations, ipConfigurationName)
+	if config == nil {
+		return fmt.Errorf("Error: IP Configuration %q was not found on Network Interface %q (Resource Group %q)", ipConfigurationName, networkInterfaceName, resourceGroup)
+	}
+
+	ipConfigProps := config.InterfaceIPConfigurationPropertiesFormat
+	if ipConfigProps == nil {
+		return fmt.Errorf("Error: `properties` was nil for IP Configuration %q (Network Interface %q / Resource Group %q)", ipConfigurationName, networkInterfaceName, resourceGroup)
+	}
+
+	pools := make([]network.BackendAddressPool, 0)
+	if ipConfigProps.LoadBalancerBackendAddressPools != nil {
+		pools = *ipConfigProps.LoadBalancerBackendAddressPools
+	}
+
+	for _, pool := range pools {
+		if pool.ID != nil && strings.EqualFold(*pool.ID, backendAddressPoolId) {
+			return fmt.Errorf("Error: A Network Interface <-> Load Balancer Backend Address Pool Association already exists between IP Configuration %q (Network Interface %q / Resource Group %q) and Backend Address Pool %q - to be managed via Terraform this association needs to be imported into the State", ipConfigurationName, networkInterfaceName, resourceGroup, backendAddressPoolId)
+		}
+	}
+
+	pools = append(pools, network.BackendAddressPool{
+		ID: utils.String(backendAddressPoolId),
+	})
+	ipConfigProps.LoadBalancerBackendAddressPools = &pools
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+	if err != nil {
+		return fmt.Errorf("Error updating Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for completion of Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	resourceId := fmt.Sprintf("%s/ipConfigurations/%s|%s", networkInterfaceId, ipConfigurationName, backendAddressPoolId)
+	d.SetId(resourceId)
+
+	return resourceArmNetworkInterfaceBackendAddressPoolAssociationRead(d, meta)
+}
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	splitId := strings.Split(d.Id(), "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("Expected ID to be in the format {networkInterfaceId}/ipConfigurations/{ipConfigurationName}|{backendAddressPoolId} but got %q", d.Id())
+	}
+
+	nicID, err := parseAzureResourceID(splitId[0])
+	if err != nil {
+		return err
+	}
+
+	ipConfigurationName := nicID.Path["ipConfigurations"]
+	networkInterfaceName := nicID.Path["networkInterfaces"]
+	resourceGroup := nicID.ResourceGroup
+	backendAddressPoolId := splitId[1]
+
+	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("Network Interface %q (Resource Group %q) was not found - removing from state!", networkInterfaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	props := read.InterfacePropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+	}
+
+	config := findNetworkInterfaceIPConfiguration(props.IPConfigurations, ipConfigurationName)
+	if config == nil {
+		log.Printf("IP Configuration %q was not found in Network Interface %q (Resource Group %q) - removing from state!", ipConfigurationName, networkInterfaceName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	found := false
+	if ipConfigProps := config.InterfaceIPConfigurationPropertiesFormat; ipConfigProps != nil {
+		if pools := ipConfigProps.LoadBalancerBackendAddressPools; pools != nil {
+			for _, pool := range *pools {
+				if pool.ID != nil && strings.EqualFold(*pool.ID, backendAddressPoolId) {
+					found = true
+					break
+				}
+			}
+		}
+	}
+
+	if !found {
+		log.Printf("[DEBUG] Association between Network Interface %q (Resource Group %q) and Load Balancer Backend Address Pool %q was not found - removing from state!", networkInterfaceName, resourceGroup, backendAddressPoolId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend_address_pool_id", backendAddressPoolId)
+	d.Set("ip_configuration_name", ipConfigurationName)
+	d.Set("network_interface_id", read.ID)
+
+	return nil
+}
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	splitId := strings.Split(d.Id(), "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("Expected ID to be in the format {networkInterfaceId}/ipConfigurations/{ipConfigurationName}|{backendAddressPoolId} but got %q", d.Id())
+	}
+
+	nicID, err := parseAzureResourceID(splitId[0])
+	if err != nil {
+		return err
+	}
+
+	ipConfigurationName := nicID.Path["ipConfigurations"]
+	networkInterfaceName := nicID.Path["networkInterfaces"]
+	resourceGroup := nicID.ResourceGroup
+	backendAddressPoolId := splitId[1]
+
+	azureRMLockByName(networkInterfaceName, networkInterfaceResourceName)
+	defer azureRMUnlockByName(networkInterfaceName, networkInterfaceResourceName)
+
+	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			// the Network Interface (and as such the Association) has been deleted
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	props := read.InterfacePropertiesFormat
+	if props == nil {
+		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+	}
+
+	config := findNetworkInterfaceIPConfiguration(props.IPConfigurations, ipConfigurationName)
+	if config == nil || config.InterfaceIPConfigurationPropertiesFormat == nil {
+		// the IP Configuration (and as such the Association) has been removed
+		return nil
+	}
+
+	ipConfigProps := config.InterfaceIPConfigurationPropertiesFormat
+	pools := make([]network.BackendAddressPool, 0)
+	if ipConfigProps.LoadBalancerBackendAddressPools != nil {
+		for _, pool := range *ipConfigProps.LoadBalancerBackendAddressPools {
+			if pool.ID != nil && strings.EqualFold(*pool.ID, backendAddressPoolId) {
+				continue
+			}
+
+			pools = append(pools, pool)
+		}
+	}
+	ipConfigProps.LoadBalancerBackendAddressPools = &pools
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+	if err != nil {
+		return fmt.Errorf("Error removing Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for removal of Backend Address Pool Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// findNetworkInterfaceIPConfiguration returns a pointer to the IP Configuration with the specified name, such that
+// it can be updated in-place
+func findNetworkInterfaceIPConfiguration(input *[]network.InterfaceIPConfiguration, name string) *network.InterfaceIPConfiguration {
+	if input == nil {
+		return nil
+	}
+
+	for i, v := range *input {
+		if v.Name != nil && *v.Name == name {
+			return &(*input)[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+)
+
+func TestUnitAzureRMNetworkInterfaceBackendAddressPoolAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_network_interface_backend_address_pool_association.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+	server.Computed("Microsoft.Network/networkInterfaces", func(nic map[string]interface{}) {
+		// like the real API, a Private IP Address is always allocated
+		configs := nic["properties"].(map[string]interface{})["ipConfigurations"].([]interface{})
+		for i, v := range configs {
+			props := v.(map[string]interface{})["properties"].(map[string]interface{})
+			if props["privateIPAddress"] == nil {
+				props["privateIPAddress"] = fmt.Sprintf("10.0.2.%d", i+4)
+			}
+		}
+	})
+
+	// the fake Resource Manager API only needs to store the ID of the Backend Address Pool on the Network Interface
+	poolId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/loadBalancers/acctestlb-%d/backendAddressPools/acctestpool", fakearm.SubscriptionID, ri, ri)
+	backendAddressPool := fmt.Sprintf(`
+locals {
+  backend_address_pool_id = "%s"
+}
+`, poolId)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_basic(ri, location, backendAddressPool),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ip_configuration_name", "testconfiguration1"),
+					resource.TestCheckResourceAttr(resourceName, "backend_address_pool_id", poolId),
+					resource.TestCheckResourceAttrPair(resourceName, "network_interface_id", "azurerm_network_interface.test", "id"),
+					testCheckFakeNetworkInterfaceBackendAddressPools(server, "azurerm_network_interface.test", poolId),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// removing the Association should remove the Backend Address Pool from the IP Configuration
+				Config: testAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_template(ri, location, backendAddressPool),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeNetworkInterfaceBackendAddressPools(server, "azurerm_network_interface.test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_network_interface_backend_address_pool_association.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkInterfaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_basic(ri, location, testAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_loadBalancer(ri)),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceBackendAddressPoolAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMNetworkInterfaceBackendAddressPoolAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		nicID, err := parseAzureResourceID(rs.Primary.Attributes["network_interface_id"])
+		if err != nil {
+			return err
+		}
+
+		networkInterfaceName := nicID.Path["networkInterfaces"]
+		resourceGroup := nicID.ResourceGroup
+		ipConfigurationName := rs.Primary.Attributes["ip_configuration_name"]
+		backendAddressPoolId := rs.Primary.Attributes["backend_address_pool_id"]
+
+		client := testAccProvider.Meta().(*ArmClient).ifaceClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on ifaceClient: %+v", err)
+		}
+
+		if props := read.InterfacePropertiesFormat; props != nil {
+			config := findNetworkInterfaceIPConfiguration(props.IPConfigurations, ipConfigurationName)
+			if config != nil && config.InterfaceIPConfigurationPropertiesFormat != nil && config.LoadBalancerBackendAddressPools != nil {
+				for _, pool := range *config.LoadBalancerBackendAddressPools {
+					if pool.ID != nil && strings.EqualFold(*pool.ID, backendAddressPoolId) {
+						return nil
+					}
+				}
+			}
+		}
+
+		return fmt.Errorf("Bad: Association between IP Configuration %q (Network Interface %q / Resource Group %q) and Backend Address Pool %q was not found", ipConfigurationName, networkInterfaceName, resourceGroup, backendAddressPoolId)
+	}
+}
+
+// testCheckFakeNetworkInterfaceBackendAddressPools checks the first IP Configuration of the Network Interface stored in
+// the fake Resource Manager API references exactly the specified Backend Address Pools
+func testCheckFakeNetworkInterfaceBackendAddressPools(server *fakearm.Server, networkInterfaceResourceName string, expected ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[networkInterfaceResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", networkInterfaceResourceName)
+		}
+
+		nic, exists := server.Get(rs.Primary.ID)
+		if !exists {
+			return fmt.Errorf("Bad: Network Interface %q does not exist in the fake Resource Manager API", rs.Primary.ID)
+		}
+
+		actual := make([]string, 0)
+		props, _ := nic["properties"].(map[string]interface{})
+		configs, _ := props["ipConfigurations"].([]interface{})
+		if len(configs) > 0 {
+			config, _ := configs[0].(map[string]interface{})
+			configProps, _ := config["properties"].(map[string]interface{})
+			pools, _ := configProps["loadBalancerBackendAddressPools"].([]interface{})
+			for _, v := range pools {
+				pool, _ := v.(map[string]interface{})
+				if id, ok := pool["id"].(string); ok {
+					actual = append(actual, id)
+				}
+			}
+		}
+
+		if strings.Join(actual, ",") != strings.Join(expected, ",") {
+			return fmt.Errorf("Bad: expected Network Interface %q to have Backend Address Pools %v but got %v", rs.Primary.ID, expected, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_basic(rInt int, location string, backendAddressPool string) string {
+	template := testAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_template(rInt, location, backendAddressPool)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_backend_address_pool_association" "test" {
+  network_interface_id    = "${azurerm_network_interface.test.id}"
+  ip_configuration_name   = "testconfiguration1"
+  backend_address_pool_id = "${local.backend_address_pool_id}"
+}
+`, template)
+}
+
+// testAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_template expects `backendAddressPool` to define the local
+// `backend_address_pool_id`, either via a Load Balancer or (in the unit tests) a stub ID
+func testAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_template(rInt int, location string, backendAddressPool string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "testsubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+  }
+}
+
+%s
+`, rInt, location, rInt, rInt, backendAddressPool)
+}
+
+func testAccAzureRMNetworkInterfaceBackendAddressPoolAssociation_loadBalancer(rInt int) string {
+	return fmt.Sprintf(`
+resource "azurerm_public_ip" "test" {
+  name                         = "test-ip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "acctestlb-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  frontend_ip_configuration {
+    name                 = "primary"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  loadbalancer_id     = "${azurerm_lb.test.id}"
+  name                = "acctestpool"
+}
+
+locals {
+  backend_address_pool_id = "${azurerm_lb_backend_address_pool.test.id}"
+}
+`, rInt, rInt)
+}
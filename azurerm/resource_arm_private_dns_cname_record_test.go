@@ -0,0 +1,136 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+)
+
+func TestUnitAzureRMPrivateDnsCNameRecord_update(t *testing.T) {
+	resourceName := "azurerm_private_dns_cname_record.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsCNameRecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/privateDnsZones/acctestzone%d.com/CNAME/testrecord%d", fakearm.SubscriptionID, ri, ri, ri)),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "300"),
+					resource.TestCheckResourceAttr(resourceName, "record", "contoso.com"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsCNameRecord_update(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "record", "www.contoso.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsCNameRecord_basic(t *testing.T) {
+	resourceName := "azurerm_private_dns_cname_record.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsRecordSetDestroy("azurerm_private_dns_cname_record", privatedns.CNAME),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsCNameRecord_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsRecordSetExists(resourceName, privatedns.CNAME),
+					resource.TestCheckResourceAttrSet(resourceName, "fqdn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsCNameRecord_update(t *testing.T) {
+	resourceName := "azurerm_private_dns_cname_record.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsRecordSetDestroy("azurerm_private_dns_cname_record", privatedns.CNAME),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsCNameRecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsRecordSetExists(resourceName, privatedns.CNAME),
+					resource.TestCheckResourceAttr(resourceName, "record", "contoso.com"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsCNameRecord_update(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsRecordSetExists(resourceName, privatedns.CNAME),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "record", "www.contoso.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMPrivateDnsCNameRecord_basic(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsZone_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_cname_record" "test" {
+  name                = "testrecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "contoso.com"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPrivateDnsCNameRecord_update(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsZone_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_cname_record" "test" {
+  name                = "testrecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "www.contoso.com"
+
+  tags {
+    environment = "staging"
+  }
+}
+`, template, rInt)
+}
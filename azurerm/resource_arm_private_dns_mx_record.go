@@ -0,0 +1,242 @@
+package azurerm
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateDnsMxRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateDnsMxRecordCreateUpdate,
+		Read:   resourceArmPrivateDnsMxRecordRead,
+		Update: resourceArmPrivateDnsMxRecordCreateUpdate,
+		Delete: resourceArmPrivateDnsMxRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"zone_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preference": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+
+						"exchange": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+				Set: resourceArmPrivateDnsMxRecordHash,
+			},
+
+			"ttl": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 2147483647),
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmPrivateDnsMxRecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsRecordSetsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+	ttl := int64(d.Get("ttl").(int))
+	tags := mergeDefaultTags(meta, d.Get("tags").(map[string]interface{}))
+
+	parameters := privatedns.RecordSet{
+		Name: utils.String(name),
+		RecordSetProperties: &privatedns.RecordSetProperties{
+			Metadata:  expandTags(tags),
+			TTL:       utils.Int64(ttl),
+			MxRecords: expandAzureRmPrivateDnsMxRecords(d.Get("record").(*schema.Set).List()),
+		},
+	}
+
+	etag := ""
+	ifNoneMatch := "" // set to empty to allow updates after creation
+	resp, err := client.CreateOrUpdate(ctx, resGroup, zoneName, privatedns.MX, name, parameters, etag, ifNoneMatch)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Private DNS MX Record %q (Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Private DNS MX Record %q (Zone %q / Resource Group %q) ID", name, zoneName, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPrivateDnsMxRecordRead(d, meta)
+}
+
+func resourceArmPrivateDnsMxRecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsRecordSetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	zoneName := id.Path["privateDnsZones"]
+	name := id.Path["MX"]
+
+	resp, err := client.Get(ctx, resGroup, zoneName, privatedns.MX, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Private DNS MX Record %q (Zone %q / Resource Group %q) was not found - removing from state", name, zoneName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Private DNS MX Record %q (Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("zone_name", zoneName)
+
+	if props := resp.RecordSetProperties; props != nil {
+		d.Set("ttl", props.TTL)
+		d.Set("fqdn", props.Fqdn)
+
+		if err := d.Set("record", flattenAzureRmPrivateDnsMxRecords(props.MxRecords)); err != nil {
+			return fmt.Errorf("Error setting `record`: %+v", err)
+		}
+
+		flattenAndSetResourceTags(d, meta, props.Metadata)
+	}
+
+	return nil
+}
+
+func resourceArmPrivateDnsMxRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsRecordSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	zoneName := id.Path["privateDnsZones"]
+	name := id.Path["MX"]
+
+	resp, err := client.Delete(ctx, resGroup, zoneName, privatedns.MX, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Private DNS MX Record %q (Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	return nil
+}
+
+func expandAzureRmPrivateDnsMxRecords(input []interface{}) *[]privatedns.MxRecord {
+	records := make([]privatedns.MxRecord, 0)
+
+	for _, v := range input {
+		record := v.(map[string]interface{})
+		preference := int32(record["preference"].(int))
+		exchange := record["exchange"].(string)
+
+		records = append(records, privatedns.MxRecord{
+			Preference: &preference,
+			Exchange:   utils.String(exchange),
+		})
+	}
+
+	return &records
+}
+
+func flattenAzureRmPrivateDnsMxRecords(input *[]privatedns.MxRecord) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input != nil {
+		for _, record := range *input {
+			preference := 0
+			if record.Preference != nil {
+				preference = int(*record.Preference)
+			}
+
+			exchange := ""
+			if record.Exchange != nil {
+				exchange = *record.Exchange
+			}
+
+			results = append(results, map[string]interface{}{
+				"preference": preference,
+				"exchange":   exchange,
+			})
+		}
+	}
+
+	return results
+}
+
+func resourceArmPrivateDnsMxRecordHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%d-", m["preference"].(int)))
+		buf.WriteString(fmt.Sprintf("%s-", m["exchange"].(string)))
+	}
+
+	return hashcode.String(buf.String())
+}
@@ -0,0 +1,159 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+)
+
+func TestUnitAzureRMPrivateDnsMxRecord_update(t *testing.T) {
+	resourceName := "azurerm_private_dns_mx_record.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsMxRecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/privateDnsZones/acctestzone%d.com/MX/testrecord%d", fakearm.SubscriptionID, ri, ri, ri)),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "300"),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsMxRecord_update(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsMxRecord_basic(t *testing.T) {
+	resourceName := "azurerm_private_dns_mx_record.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsRecordSetDestroy("azurerm_private_dns_mx_record", privatedns.MX),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsMxRecord_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsRecordSetExists(resourceName, privatedns.MX),
+					resource.TestCheckResourceAttrSet(resourceName, "fqdn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsMxRecord_update(t *testing.T) {
+	resourceName := "azurerm_private_dns_mx_record.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsRecordSetDestroy("azurerm_private_dns_mx_record", privatedns.MX),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsMxRecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsRecordSetExists(resourceName, privatedns.MX),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsMxRecord_update(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsRecordSetExists(resourceName, privatedns.MX),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAzureRMPrivateDnsMxRecord_basic(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsZone_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_mx_record" "test" {
+  name                = "testrecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+
+  record {
+    preference = 10
+    exchange   = "mx1.contoso.com"
+  }
+
+  record {
+    preference = 20
+    exchange   = "mx2.contoso.com"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPrivateDnsMxRecord_update(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsZone_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_mx_record" "test" {
+  name                = "testrecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+
+  record {
+    preference = 10
+    exchange   = "mx1.contoso.com"
+  }
+
+  record {
+    preference = 20
+    exchange   = "mx2.contoso.com"
+  }
+
+  record {
+    preference = 50
+    exchange   = "mx3.contoso.com"
+  }
+
+  tags {
+    environment = "staging"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,202 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateDnsPtrRecord() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateDnsPtrRecordCreateUpdate,
+		Read:   resourceArmPrivateDnsPtrRecordRead,
+		Update: resourceArmPrivateDnsPtrRecordCreateUpdate,
+		Delete: resourceArmPrivateDnsPtrRecordDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"zone_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"records": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+				Set: schema.HashString,
+			},
+
+			"ttl": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 2147483647),
+			},
+
+			"fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmPrivateDnsPtrRecordCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsRecordSetsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+	ttl := int64(d.Get("ttl").(int))
+	tags := mergeDefaultTags(meta, d.Get("tags").(map[string]interface{}))
+
+	parameters := privatedns.RecordSet{
+		Name: utils.String(name),
+		RecordSetProperties: &privatedns.RecordSetProperties{
+			Metadata:   expandTags(tags),
+			TTL:        utils.Int64(ttl),
+			PtrRecords: expandAzureRmPrivateDnsPtrRecords(d.Get("records").(*schema.Set).List()),
+		},
+	}
+
+	etag := ""
+	ifNoneMatch := "" // set to empty to allow updates after creation
+	resp, err := client.CreateOrUpdate(ctx, resGroup, zoneName, privatedns.PTR, name, parameters, etag, ifNoneMatch)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Private DNS PTR Record %q (Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Private DNS PTR Record %q (Zone %q / Resource Group %q) ID", name, zoneName, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPrivateDnsPtrRecordRead(d, meta)
+}
+
+func resourceArmPrivateDnsPtrRecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsRecordSetsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	zoneName := id.Path["privateDnsZones"]
+	name := id.Path["PTR"]
+
+	resp, err := client.Get(ctx, resGroup, zoneName, privatedns.PTR, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Private DNS PTR Record %q (Zone %q / Resource Group %q) was not found - removing from state", name, zoneName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Private DNS PTR Record %q (Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resGroup)
+	d.Set("zone_name", zoneName)
+
+	if props := resp.RecordSetProperties; props != nil {
+		d.Set("ttl", props.TTL)
+		d.Set("fqdn", props.Fqdn)
+
+		if err := d.Set("records", flattenAzureRmPrivateDnsPtrRecords(props.PtrRecords)); err != nil {
+			return fmt.Errorf("Error setting `records`: %+v", err)
+		}
+
+		flattenAndSetResourceTags(d, meta, props.Metadata)
+	}
+
+	return nil
+}
+
+func resourceArmPrivateDnsPtrRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsRecordSetsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	zoneName := id.Path["privateDnsZones"]
+	name := id.Path["PTR"]
+
+	resp, err := client.Delete(ctx, resGroup, zoneName, privatedns.PTR, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Private DNS PTR Record %q (Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	return nil
+}
+
+func expandAzureRmPrivateDnsPtrRecords(input []interface{}) *[]privatedns.PtrRecord {
+	records := make([]privatedns.PtrRecord, 0)
+
+	for _, v := range input {
+		records = append(records, privatedns.PtrRecord{
+			Ptrdname: utils.String(v.(string)),
+		})
+	}
+
+	return &records
+}
+
+func flattenAzureRmPrivateDnsPtrRecords(input *[]privatedns.PtrRecord) []string {
+	results := make([]string, 0)
+
+	if input != nil {
+		for _, record := range *input {
+			if record.Ptrdname != nil {
+				results = append(results, *record.Ptrdname)
+			}
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,183 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmPrivateDnsZoneVirtualNetworkLink() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmPrivateDnsZoneVirtualNetworkLinkCreateUpdate,
+		Read:   resourceArmPrivateDnsZoneVirtualNetworkLinkRead,
+		Update: resourceArmPrivateDnsZoneVirtualNetworkLinkCreateUpdate,
+		Delete: resourceArmPrivateDnsZoneVirtualNetworkLinkDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"private_dns_zone_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// the Virtual Network can be within another Resource Group or Subscription, which allows
+			// Virtual Networks owned by another team to be linked to this Zone
+			"virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"resource_group_name": resourceGroupNameDiffSuppressSchema(),
+
+			"registration_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmPrivateDnsZoneVirtualNetworkLinkCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsVirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	zoneName := d.Get("private_dns_zone_name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+	virtualNetworkId := d.Get("virtual_network_id").(string)
+	registrationEnabled := d.Get("registration_enabled").(bool)
+	tags := mergeDefaultTags(meta, d.Get("tags").(map[string]interface{}))
+
+	parameters := privatedns.VirtualNetworkLink{
+		Location: utils.String(privateDnsZoneLocation),
+		Tags:     expandTags(tags),
+		VirtualNetworkLinkProperties: &privatedns.VirtualNetworkLinkProperties{
+			VirtualNetwork: &privatedns.SubResource{
+				ID: utils.String(virtualNetworkId),
+			},
+			RegistrationEnabled: utils.Bool(registrationEnabled),
+		},
+	}
+
+	etag := ""
+	ifNoneMatch := "" // set to empty to allow updates after creation
+	future, err := client.CreateOrUpdate(ctx, resGroup, zoneName, name, parameters, etag, ifNoneMatch)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Network Link %q (Private DNS Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Virtual Network Link %q (Private DNS Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	resp, err := client.Get(ctx, resGroup, zoneName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Network Link %q (Private DNS Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Network Link %q (Private DNS Zone %q / Resource Group %q) ID", name, zoneName, resGroup)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmPrivateDnsZoneVirtualNetworkLinkRead(d, meta)
+}
+
+func resourceArmPrivateDnsZoneVirtualNetworkLinkRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsVirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	zoneName := id.Path["privateDnsZones"]
+	name := id.Path["virtualNetworkLinks"]
+
+	resp, err := client.Get(ctx, resGroup, zoneName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Network Link %q (Private DNS Zone %q / Resource Group %q) was not found - removing from state", name, zoneName, resGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Virtual Network Link %q (Private DNS Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("private_dns_zone_name", zoneName)
+	d.Set("resource_group_name", resGroup)
+
+	if props := resp.VirtualNetworkLinkProperties; props != nil {
+		d.Set("registration_enabled", props.RegistrationEnabled)
+
+		if network := props.VirtualNetwork; network != nil {
+			d.Set("virtual_network_id", network.ID)
+		}
+	}
+
+	flattenAndSetResourceTags(d, meta, resp.Tags)
+
+	return nil
+}
+
+func resourceArmPrivateDnsZoneVirtualNetworkLinkDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).privateDnsVirtualNetworkLinksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resGroup := id.ResourceGroup
+	zoneName := id.Path["privateDnsZones"]
+	name := id.Path["virtualNetworkLinks"]
+
+	future, err := client.Delete(ctx, resGroup, zoneName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error deleting Virtual Network Link %q (Private DNS Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Virtual Network Link %q (Private DNS Zone %q / Resource Group %q): %+v", name, zoneName, resGroup, err)
+	}
+
+	return nil
+}
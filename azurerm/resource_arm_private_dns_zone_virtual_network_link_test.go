@@ -0,0 +1,182 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestUnitAzureRMPrivateDnsZoneVirtualNetworkLink_update(t *testing.T) {
+	resourceName := "azurerm_private_dns_zone_virtual_network_link.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "virtual_network_id", "azurerm_virtual_network.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "registration_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "registration_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(t *testing.T) {
+	resourceName := "azurerm_private_dns_zone_virtual_network_link.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(ri, testLocation(), false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsZoneVirtualNetworkLink_registrationEnabled(t *testing.T) {
+	resourceName := "azurerm_private_dns_zone_virtual_network_link.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "registration_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "registration_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["private_dns_zone_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).privateDnsVirtualNetworkLinksClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, zoneName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Network Link %q (Private DNS Zone %q / Resource Group %q) does not exist", name, zoneName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on privateDnsVirtualNetworkLinksClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).privateDnsVirtualNetworkLinksClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_dns_zone_virtual_network_link" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		zoneName := rs.Primary.Attributes["private_dns_zone_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, zoneName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual Network Link %q (Private DNS Zone %q / Resource Group %q) still exists", name, zoneName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(rInt int, location string, registrationEnabled bool) string {
+	template := testAccAzureRMPrivateDnsZone_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+# the Virtual Network is intentionally within a different Resource Group to the Private DNS Zone
+resource "azurerm_resource_group" "network" {
+  name     = "acctestRG-network-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.network.location}"
+  resource_group_name = "${azurerm_resource_group.network.name}"
+}
+
+resource "azurerm_private_dns_zone_virtual_network_link" "test" {
+  name                  = "acctestlink%d"
+  private_dns_zone_name = "${azurerm_private_dns_zone.test.name}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  virtual_network_id    = "${azurerm_virtual_network.test.id}"
+  registration_enabled  = %t
+}
+`, template, rInt, location, rInt, rInt, registrationEnabled)
+}
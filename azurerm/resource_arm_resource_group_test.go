@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -129,6 +130,183 @@ func TestAccAzureRMResourceGroup_withTags(t *testing.T) {
 	})
 }
 
+func TestUnitAzureRMResourceGroup_withTags(t *testing.T) {
+	resourceName := "azurerm_resource_group.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroup_withTags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "location", location),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.cost_center", "MSFT"),
+				),
+			},
+			{
+				Config: testAccAzureRMResourceGroup_withTagsUpdated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "staging"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitAzureRMResourceGroup_defaultTags(t *testing.T) {
+	resourceName := "azurerm_resource_group.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroup_defaultTags(ri, location, "Infrastructure"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.cost_center", "MSFT"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.environment", "Production"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.cost_center", "MSFT"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.owner", "Infrastructure"),
+				),
+			},
+			{
+				Config: testAccAzureRMResourceGroup_defaultTags(ri, location, "Networking"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.owner", "Networking"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitAzureRMResourceGroup_interpolatedTags(t *testing.T) {
+	resourceName := "azurerm_resource_group.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroup_interpolatedTags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "3"),
+					resource.TestCheckResourceAttrPair(resourceName, "tags_all.other", "azurerm_resource_group.other", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitAzureRMResourceGroup_ignoreTags(t *testing.T) {
+	resourceName := "azurerm_resource_group.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	config := testAccAzureRMResourceGroup_ignoreTags(ri, location)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				// simulate Azure Policy appending tags to the Resource Group outside of Terraform
+				PreConfig: func() {
+					id := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d", fakearm.SubscriptionID, ri)
+					resourceGroup, _ := server.Get(id)
+					tags := resourceGroup["tags"].(map[string]interface{})
+					tags["CreatedBy"] = "policy"
+					tags["hidden-link:/app-insights"] = "Resource"
+					server.Put(id, resourceGroup)
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Production"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitAzureRMResourceGroup_subscriptionOverride(t *testing.T) {
+	resourceName := "azurerm_resource_group.test"
+	otherResourceName := "azurerm_resource_group.other"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	otherSubscriptionId := "11111111-1111-1111-1111-111111111111"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroup_subscriptionOverride(ri, location, otherSubscriptionId),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					testCheckFakeResourceExists(server, otherResourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d", fakearm.SubscriptionID, ri)),
+					resource.TestCheckResourceAttr(resourceName, "subscription_id", fakearm.SubscriptionID),
+					resource.TestCheckResourceAttr(otherResourceName, "id", fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-other-%d", otherSubscriptionId, ri)),
+					resource.TestCheckResourceAttr(otherResourceName, "subscription_id", otherSubscriptionId),
+				),
+			},
+			{
+				ResourceName:      otherResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMResourceGroupExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -243,3 +421,88 @@ resource "azurerm_resource_group" "test" {
 }
 `, rInt, location)
 }
+
+func testAccAzureRMResourceGroup_defaultTags(rInt int, location string, owner string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  default_tags {
+    tags {
+      environment = "Default"
+      owner       = "%s"
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  tags {
+    environment = "Production"
+    cost_center = "MSFT"
+  }
+}
+`, owner, rInt, location)
+}
+
+func testAccAzureRMResourceGroup_interpolatedTags(rInt int, location string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  default_tags {
+    tags {
+      owner = "Infrastructure"
+    }
+  }
+}
+
+resource "azurerm_resource_group" "other" {
+  name     = "acctestRG-other-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  tags {
+    environment = "Production"
+    other       = "${azurerm_resource_group.other.id}"
+  }
+}
+`, rInt, location, rInt, location)
+}
+
+func testAccAzureRMResourceGroup_ignoreTags(rInt int, location string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  ignore_tags {
+    keys         = ["createdby"]
+    key_prefixes = ["hidden-link:"]
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  tags {
+    environment = "Production"
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMResourceGroup_subscriptionOverride(rInt int, location string, subscriptionId string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group" "other" {
+  name            = "acctestRG-other-%d"
+  location        = "%s"
+  subscription_id = "%s"
+}
+`, rInt, location, rInt, location, subscriptionId)
+}
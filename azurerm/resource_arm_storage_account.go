@@ -6,10 +6,12 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2017-10-01/storage"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -28,6 +30,13 @@ func resourceArmStorageAccount() *schema.Resource {
 		MigrateState:  resourceStorageAccountMigrateState,
 		SchemaVersion: 2,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -330,7 +339,7 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	accountKind := d.Get("account_kind").(string)
 
 	location := azureRMNormalizeLocation(d.Get("location").(string))
-	tags := d.Get("tags").(map[string]interface{})
+	tags := mergeDefaultTags(meta, d.Get("tags").(map[string]interface{}))
 	enableBlobEncryption := d.Get("enable_blob_encryption").(bool)
 	enableFileEncryption := d.Get("enable_file_encryption").(bool)
 	enableHTTPSTrafficOnly := d.Get("enable_https_traffic_only").(bool)
@@ -393,7 +402,8 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	// Create
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	future, err := client.Create(ctx, resourceGroupName, storageAccountName, parameters)
 	if err != nil {
 		return fmt.Errorf("Error creating Azure Storage Account %q: %+v", storageAccountName, err)
@@ -423,7 +433,8 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 // and idempotent operation for CreateOrUpdate. In particular updating all of the parameters
 // available requires a call to Update per parameter...
 func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -478,8 +489,8 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("access_tier")
 	}
 
-	if d.HasChange("tags") {
-		tags := d.Get("tags").(map[string]interface{})
+	if d.HasChange("tags") || d.HasChange("tags_all") {
+		tags := mergeDefaultTags(meta, d.Get("tags").(map[string]interface{}))
 
 		opts := storage.AccountUpdateParameters{
 			Tags: expandTags(tags),
@@ -590,7 +601,8 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 	endpointSuffix := meta.(*ArmClient).environment.StorageEndpointSuffix
 
@@ -716,13 +728,14 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, meta, resp.Tags)
 
 	return nil
 }
 
 func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 
 	id, err := parseAzureResourceID(d.Id())
@@ -829,7 +842,7 @@ func expandStorageAccountBypass(networkRule map[string]interface{}) storage.Bypa
 }
 
 func flattenStorageAccountNetworkRules(input *storage.NetworkRuleSet) []interface{} {
-	if len(*input.IPRules) == 0 && len(*input.VirtualNetworkRules) == 0 {
+	if (input.IPRules == nil || len(*input.IPRules) == 0) && (input.VirtualNetworkRules == nil || len(*input.VirtualNetworkRules) == 0) {
 		return []interface{}{}
 	}
 	networkRules := make(map[string]interface{}, 0)
@@ -842,10 +855,10 @@ func flattenStorageAccountNetworkRules(input *storage.NetworkRuleSet) []interfac
 }
 
 func flattenStorageAccountIPRules(input *[]storage.IPRule) []interface{} {
-	ipRules := make([]interface{}, len(*input))
+	ipRules := make([]interface{}, 0)
 	if input != nil {
-		for i, ipRule := range *input {
-			ipRules[i] = *ipRule.IPAddressOrRange
+		for _, ipRule := range *input {
+			ipRules = append(ipRules, *ipRule.IPAddressOrRange)
 		}
 	}
 
@@ -853,11 +866,11 @@ func flattenStorageAccountIPRules(input *[]storage.IPRule) []interface{} {
 }
 
 func flattenStorageAccountVirtualNetworks(input *[]storage.VirtualNetworkRule) []interface{} {
-	virtualNetworks := make([]interface{}, len(*input))
+	virtualNetworks := make([]interface{}, 0)
 
 	if input != nil {
-		for i, virtualNetwork := range *input {
-			virtualNetworks[i] = *virtualNetwork.VirtualNetworkResourceID
+		for _, virtualNetwork := range *input {
+			virtualNetworks = append(virtualNetworks, *virtualNetwork.VirtualNetworkResourceID)
 		}
 	}
 
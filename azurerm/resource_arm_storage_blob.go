@@ -2,20 +2,27 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
+	"crypto/md5"
 	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
 	"net/url"
 	"os"
+	"regexp"
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 )
 
 func resourceArmStorageBlob() *schema.Resource {
@@ -26,10 +33,18 @@ func resourceArmStorageBlob() *schema.Resource {
 		Delete:        resourceArmStorageBlobDelete,
 		MigrateState:  resourceStorageBlobMigrateState,
 		SchemaVersion: 1,
+		CustomizeDiff: resourceArmStorageBlobCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -66,17 +81,58 @@ func resourceArmStorageBlob() *schema.Resource {
 				Default:       "application/octet-stream",
 				ConflictsWith: []string{"source_uri"},
 			},
+			"cache_control": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source_uri"},
+			},
+			"content_encoding": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source_uri"},
+			},
+			"content_disposition": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source_uri"},
+			},
 			"source": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_uri"},
+				ConflictsWith: []string{"source_uri", "source_content"},
+			},
+			"source_content": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"source", "source_uri"},
 			},
 			"source_uri": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source"},
+				ConflictsWith: []string{"source", "source_content"},
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ValidateFunc: validateArmStorageBlobMetadata,
+			},
+			"access_tier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Hot",
+					"Cool",
+					"Archive",
+				}, false),
+			},
+			"content_md5": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"source_uri"},
 			},
 			"url": {
 				Type:     schema.TypeString,
@@ -104,7 +160,7 @@ func validateArmStorageBlobParallelism(v interface{}, k string) (ws []string, er
 	value := v.(int)
 
 	if value <= 0 {
-		errors = append(errors, fmt.Errorf("Blob Parallelism %q is invalid, must be greater than 0", value))
+		errors = append(errors, fmt.Errorf("Blob Parallelism %d is invalid, must be greater than 0", value))
 	}
 
 	return
@@ -114,7 +170,7 @@ func validateArmStorageBlobAttempts(v interface{}, k string) (ws []string, error
 	value := v.(int)
 
 	if value <= 0 {
-		errors = append(errors, fmt.Errorf("Blob Attempts %q is invalid, must be greater than 0", value))
+		errors = append(errors, fmt.Errorf("Blob Attempts %d is invalid, must be greater than 0", value))
 	}
 
 	return
@@ -124,7 +180,20 @@ func validateArmStorageBlobSize(v interface{}, k string) (ws []string, errors []
 	value := v.(int)
 
 	if value%512 != 0 {
-		errors = append(errors, fmt.Errorf("Blob Size %q is invalid, must be a multiple of 512", value))
+		errors = append(errors, fmt.Errorf("Blob Size %d is invalid, must be a multiple of 512", value))
+	}
+
+	return
+}
+
+func validateArmStorageBlobMetadata(v interface{}, k string) (ws []string, errors []error) {
+	metadata := v.(map[string]interface{})
+
+	for name := range metadata {
+		// metadata names are case-insensitive, and returned in lower-case by the API
+		if !regexp.MustCompile(`^[a-z_][a-z0-9_]*$`).MatchString(name) {
+			errors = append(errors, fmt.Errorf("Blob Metadata name %q is invalid, must be a lower-case C# identifier (e.g. `environment`)", name))
+		}
 	}
 
 	return
@@ -143,9 +212,106 @@ func validateArmStorageBlobType(v interface{}, k string) (ws []string, errors []
 	return
 }
 
+// resourceArmStorageBlobCustomizeDiff validates the options are supported by the blob `type`, and compares the MD5 hash of
+// the `source` file (or `source_content`) with the `content_md5` of the blob, such that the blob is re-uploaded when the
+// contents have changed
+func resourceArmStorageBlobCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// Access Tiers & uploading `source_content` are only supported for Block Blobs - since the `type` may not be known
+	// until it's applied (or isn't specified) these are also validated during Create
+	if blobType := d.Get("type").(string); blobType != "" && !strings.EqualFold(blobType, "block") {
+		if _, ok := d.GetOk("source_content"); ok {
+			return fmt.Errorf("`source_content` can only be specified for `block` blobs")
+		}
+		if _, ok := d.GetOk("access_tier"); ok && (d.Id() == "" || d.HasChange("access_tier")) {
+			return fmt.Errorf("`access_tier` can only be specified for `block` blobs")
+		}
+	}
+
+	if d.Id() == "" || d.HasChange("content_md5") {
+		return nil
+	}
+
+	// blobs uploaded before the `content_md5` was tracked (as well as Page Blobs, which Azure doesn't calculate the
+	// MD5 of) don't have a Content-MD5 - in which case their contents can't be compared, and rather than re-uploading
+	// them (e.g. a VHD which may be leased/attached to a Virtual Machine) they're only re-uploaded when the config changes
+	existing := d.Get("content_md5").(string)
+
+	var hash []byte
+	if sourceContent, ok := d.GetOk("source_content"); ok {
+		if existing == "" && !d.HasChange("source_content") {
+			return nil
+		}
+
+		sum := md5.Sum([]byte(sourceContent.(string)))
+		hash = sum[:]
+	} else if d.HasChange("source_content") {
+		// the `source_content` may not be known until it's applied
+		return d.SetNewComputed("content_md5")
+	} else {
+		source := d.Get("source").(string)
+		if source == "" || d.HasChange("source") {
+			return nil
+		}
+
+		if existing == "" {
+			log.Printf("[DEBUG] Blob %q has no Content-MD5 - unable to compare it to the source file %q", d.Id(), source)
+			return nil
+		}
+
+		sourceHash, err := resourceArmStorageBlobSourceMD5(source)
+		if err != nil {
+			// the file may not exist yet (e.g. when it's generated by another resource), in which case it's uploaded as-is
+			log.Printf("[DEBUG] Unable to determine the MD5 hash of the source file %q: %+v", source, err)
+			return nil
+		}
+		hash = sourceHash
+	}
+
+	contentMD5 := hex.EncodeToString(hash)
+	if !strings.EqualFold(existing, contentMD5) {
+		log.Printf("[DEBUG] The contents of Blob %q have changed (MD5 %q, was %q) - re-uploading", d.Id(), contentMD5, existing)
+		return d.SetNew("content_md5", contentMD5)
+	}
+
+	return nil
+}
+
+// resourceArmStorageBlobSourceMD5 returns the MD5 hash of the contents of the source file
+func resourceArmStorageBlobSourceMD5(source string) ([]byte, error) {
+	file, err := os.Open(source)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening source file %q: %s", source, err)
+	}
+	defer file.Close()
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return nil, fmt.Errorf("Error reading source file %q: %s", source, err)
+	}
+
+	return hash.Sum(nil), nil
+}
+
+// flattenStorageBlobContentMD5 converts the (base64 encoded) `Content-MD5` of a blob into the
+// hex encoding used by Terraform's `md5` interpolation function
+func flattenStorageBlobContentMD5(input string) string {
+	if input == "" {
+		return ""
+	}
+
+	hash, err := base64.StdEncoding.DecodeString(input)
+	if err != nil {
+		log.Printf("[DEBUG] Unable to decode the Content-MD5 %q: %+v", input, err)
+		return ""
+	}
+
+	return hex.EncodeToString(hash)
+}
+
 func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreate(armClient.StopContext, d)
+	defer cancel()
 	env := armClient.environment
 
 	resourceGroupName := d.Get("resource_group_name").(string)
@@ -163,7 +329,16 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 	blobType := d.Get("type").(string)
 	containerName := d.Get("storage_container_name").(string)
 	sourceUri := d.Get("source_uri").(string)
+	sourceContent := d.Get("source_content").(string)
 	contentType := d.Get("content_type").(string)
+	accessTier := d.Get("access_tier").(string)
+
+	if sourceContent != "" && !strings.EqualFold(blobType, "block") {
+		return fmt.Errorf("`source_content` can only be specified for `block` blobs")
+	}
+	if accessTier != "" && !strings.EqualFold(blobType, "block") {
+		return fmt.Errorf("`access_tier` can only be specified for `block` blobs")
+	}
 
 	log.Printf("[INFO] Creating blob %q in container %q within storage account %q", name, containerName, storageAccountName)
 	container := blobClient.GetContainerReference(containerName)
@@ -178,6 +353,13 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		switch strings.ToLower(blobType) {
 		case "block":
+			if sourceContent != "" {
+				if err := resourceArmStorageBlobBlockUploadFromContent(blob, sourceContent, contentType); err != nil {
+					return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+				}
+				break
+			}
+
 			options := &storage.PutBlobOptions{}
 			err := blob.CreateBlockBlob(options)
 			if err != nil {
@@ -216,12 +398,113 @@ func resourceArmStorageBlobCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
+	// the Content Type is set when the blob's uploaded, however the other properties need to be set separately
+	if d.Get("cache_control").(string) != "" || d.Get("content_encoding").(string) != "" || d.Get("content_disposition").(string) != "" {
+		if err := resourceArmStorageBlobSetProperties(d, blob); err != nil {
+			return err
+		}
+	}
+
+	if metadata := d.Get("metadata").(map[string]interface{}); len(metadata) > 0 {
+		if err := resourceArmStorageBlobSetMetadata(d, blob); err != nil {
+			return err
+		}
+	}
+
 	// gives us https://example.blob.core.windows.net/container/file.vhd
 	id := fmt.Sprintf("https://%s.blob.%s/%s/%s", storageAccountName, env.StorageEndpointSuffix, containerName, name)
+
+	// the Access Tier is set last, since the properties of a blob in the Archive tier can't be changed
+	if accessTier != "" {
+		if err := resourceArmStorageBlobSetAccessTier(ctx, armClient, resourceGroupName, storageAccountName, id, accessTier); err != nil {
+			return err
+		}
+	}
+
 	d.SetId(id)
 	return resourceArmStorageBlobRead(d, meta)
 }
 
+func resourceArmStorageBlobBlockUploadFromContent(blob *storage.Blob, content, contentType string) error {
+	contentMD5 := md5.Sum([]byte(content))
+
+	blob.Properties.ContentType = contentType
+	blob.Properties.ContentMD5 = base64.StdEncoding.EncodeToString(contentMD5[:])
+	options := &storage.PutBlobOptions{}
+	return blob.CreateBlockBlobFromReader(strings.NewReader(content), options)
+}
+
+func resourceArmStorageBlobSetProperties(d *schema.ResourceData, blob *storage.Blob) error {
+	// retrieve the existing properties first, since any which aren't specified (e.g. the Content-MD5) are cleared
+	if err := blob.GetProperties(&storage.GetBlobPropertiesOptions{}); err != nil {
+		return fmt.Errorf("Error getting properties of blob %s (container %s): %+v", blob.Name, blob.Container.Name, err)
+	}
+
+	blob.Properties.ContentType = d.Get("content_type").(string)
+	blob.Properties.CacheControl = d.Get("cache_control").(string)
+	blob.Properties.ContentEncoding = d.Get("content_encoding").(string)
+	blob.Properties.ContentDisposition = d.Get("content_disposition").(string)
+
+	options := &storage.SetBlobPropertiesOptions{}
+	if err := blob.SetProperties(options); err != nil {
+		return fmt.Errorf("Error setting properties of blob %s (container %s): %+v", blob.Name, blob.Container.Name, err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageBlobSetMetadata(d *schema.ResourceData, blob *storage.Blob) error {
+	blob.Metadata = expandStorageBlobMetadata(d.Get("metadata").(map[string]interface{}))
+
+	options := &storage.SetBlobMetadataOptions{}
+	if err := blob.SetMetadata(options); err != nil {
+		return fmt.Errorf("Error setting metadata of blob %s (container %s): %+v", blob.Name, blob.Container.Name, err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageBlobSetAccessTier(ctx context.Context, armClient *ArmClient, resourceGroup, storageAccountName, blobUrl, accessTier string) error {
+	tierClient, accountExists, err := armClient.getStorageBlobAccessTierClient(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return err
+	}
+	if !accountExists {
+		return fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	log.Printf("[INFO] Setting the Access Tier of blob %q to %q", blobUrl, accessTier)
+	return tierClient.Set(ctx, blobUrl, accessTier)
+}
+
+func resourceArmStorageBlobGetAccessTier(ctx context.Context, armClient *ArmClient, resourceGroup, storageAccountName, blobUrl string) (string, error) {
+	tierClient, accountExists, err := armClient.getStorageBlobAccessTierClient(ctx, resourceGroup, storageAccountName)
+	if err != nil {
+		return "", err
+	}
+	if !accountExists {
+		return "", fmt.Errorf("Storage Account %q Not Found", storageAccountName)
+	}
+
+	return tierClient.Get(ctx, blobUrl)
+}
+
+func expandStorageBlobMetadata(input map[string]interface{}) storage.BlobMetadata {
+	output := make(storage.BlobMetadata, len(input))
+	for k, v := range input {
+		output[k] = v.(string)
+	}
+	return output
+}
+
+func flattenStorageBlobMetadata(input storage.BlobMetadata) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		output[k] = v
+	}
+	return output
+}
+
 type resourceArmStorageBlobPage struct {
 	offset  int64
 	section *io.SectionReader
@@ -241,11 +524,18 @@ func resourceArmStorageBlobPageUploadFromSource(container, name, source, content
 		return fmt.Errorf("Error splitting source file %q into pages: %s", source, err)
 	}
 
+	// the Content-MD5 of a blob which is uploaded in pages isn't calculated by the API
+	contentMD5, err := resourceArmStorageBlobSourceMD5(source)
+	if err != nil {
+		return err
+	}
+
 	options := &storage.PutBlobOptions{}
 	containerRef := client.GetContainerReference(container)
 	blob := containerRef.GetBlobReference(name)
 	blob.Properties.ContentLength = blobSize
 	blob.Properties.ContentType = contentType
+	blob.Properties.ContentMD5 = base64.StdEncoding.EncodeToString(contentMD5)
 	err = blob.PutPageBlob(options)
 	if err != nil {
 		return fmt.Errorf("Error creating storage blob on Azure: %s", err)
@@ -419,6 +709,12 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 		return fmt.Errorf("Error reading and splitting source file for upload %q: %s", source, err)
 	}
 
+	// the Content-MD5 of a blob which is uploaded in blocks isn't calculated by the API
+	contentMD5, err := resourceArmStorageBlobSourceMD5(source)
+	if err != nil {
+		return err
+	}
+
 	wg := &sync.WaitGroup{}
 	blocks := make(chan resourceArmStorageBlobBlock, len(parts))
 	errors := make(chan error, len(parts))
@@ -451,6 +747,7 @@ func resourceArmStorageBlobBlockUploadFromSource(container, name, source, conten
 	containerReference := client.GetContainerReference(container)
 	blobReference := containerReference.GetBlobReference(name)
 	blobReference.Properties.ContentType = contentType
+	blobReference.Properties.ContentMD5 = base64.StdEncoding.EncodeToString(contentMD5)
 	options := &storage.PutBlockListOptions{}
 	err = blobReference.PutBlockList(blockList, options)
 	if err != nil {
@@ -545,7 +842,8 @@ func resourceArmStorageBlobBlockUploadWorker(ctx resourceArmStorageBlobBlockUplo
 
 func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageBlobID(d.Id(), armClient.environment)
 	if err != nil {
@@ -572,22 +870,61 @@ func resourceArmStorageBlobUpdate(d *schema.ResourceData, meta interface{}) erro
 	container := blobClient.GetContainerReference(id.containerName)
 	blob := container.GetBlobReference(id.blobName)
 
-	if d.HasChange("content_type") {
-		blob.Properties.ContentType = d.Get("content_type").(string)
+	// the contents of the blob have changed, so it's re-uploaded in-place
+	reuploaded := false
+	if d.HasChange("content_md5") {
+		contentType := d.Get("content_type").(string)
+		parallelism := d.Get("parallelism").(int)
+		attempts := d.Get("attempts").(int)
+
+		if sourceContent := d.Get("source_content").(string); sourceContent != "" {
+			log.Printf("[INFO] Re-uploading blob %q in container %q within storage account %q", id.blobName, id.containerName, id.storageAccountName)
+			if err := resourceArmStorageBlobBlockUploadFromContent(blob, sourceContent, contentType); err != nil {
+				return fmt.Errorf("Error re-uploading storage blob %q (container %q, storage account %q): %s", id.blobName, id.containerName, id.storageAccountName, err)
+			}
+			reuploaded = true
+		} else if source := d.Get("source").(string); source != "" {
+			log.Printf("[INFO] Re-uploading blob %q in container %q within storage account %q", id.blobName, id.containerName, id.storageAccountName)
+			switch strings.ToLower(d.Get("type").(string)) {
+			case "block":
+				if err := resourceArmStorageBlobBlockUploadFromSource(id.containerName, id.blobName, source, contentType, blobClient, parallelism, attempts); err != nil {
+					return fmt.Errorf("Error re-uploading storage blob %q (container %q, storage account %q): %s", id.blobName, id.containerName, id.storageAccountName, err)
+				}
+			case "page":
+				if err := resourceArmStorageBlobPageUploadFromSource(id.containerName, id.blobName, source, contentType, blobClient, parallelism, attempts); err != nil {
+					return fmt.Errorf("Error re-uploading storage blob %q (container %q, storage account %q): %s", id.blobName, id.containerName, id.storageAccountName, err)
+				}
+			}
+			reuploaded = true
+		}
 	}
 
-	options := &storage.SetBlobPropertiesOptions{}
-	err = blob.SetProperties(options)
-	if err != nil {
-		return fmt.Errorf("Error setting properties of blob %s (container %s, storage account %s): %+v", id.blobName, id.containerName, id.storageAccountName, err)
+	// re-uploading a blob replaces its properties and metadata
+	if reuploaded || d.HasChange("content_type") || d.HasChange("cache_control") || d.HasChange("content_encoding") || d.HasChange("content_disposition") {
+		if err := resourceArmStorageBlobSetProperties(d, blob); err != nil {
+			return err
+		}
 	}
 
-	return nil
+	if reuploaded || d.HasChange("metadata") {
+		if err := resourceArmStorageBlobSetMetadata(d, blob); err != nil {
+			return err
+		}
+	}
+
+	if accessTier := d.Get("access_tier").(string); accessTier != "" && (reuploaded || d.HasChange("access_tier")) {
+		if err := resourceArmStorageBlobSetAccessTier(ctx, armClient, *resourceGroup, id.storageAccountName, d.Id(), accessTier); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmStorageBlobRead(d, meta)
 }
 
 func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForRead(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageBlobID(d.Id(), armClient.environment)
 	if err != nil {
@@ -639,12 +976,34 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("resource_group_name", resourceGroup)
 
 	d.Set("content_type", blob.Properties.ContentType)
+	d.Set("content_md5", flattenStorageBlobContentMD5(blob.Properties.ContentMD5))
+	d.Set("cache_control", blob.Properties.CacheControl)
+	d.Set("content_encoding", blob.Properties.ContentEncoding)
+	d.Set("content_disposition", blob.Properties.ContentDisposition)
+
+	if err := d.Set("metadata", flattenStorageBlobMetadata(blob.Metadata)); err != nil {
+		return fmt.Errorf("Error setting `metadata`: %+v", err)
+	}
 
 	d.Set("source_uri", blob.Properties.CopySource)
 
 	blobType := strings.ToLower(strings.Replace(string(blob.Properties.BlobType), "Blob", "", 1))
 	d.Set("type", blobType)
 
+	// Access Tiers are only supported for Block Blobs
+	if blobType == "block" {
+		accessTier, err := resourceArmStorageBlobGetAccessTier(ctx, armClient, *resourceGroup, id.storageAccountName, d.Id())
+		if err != nil {
+			// the Access Tier is retrieved using a separate request (authenticated with an Account SAS) which can fail
+			// independently of the blob itself, e.g. when Shared Key access is restricted - in which case it's left as-is
+			log.Printf("[WARN] Unable to retrieve the Access Tier of blob %q - leaving it unchanged: %+v", id.blobName, err)
+		} else {
+			d.Set("access_tier", accessTier)
+		}
+	} else {
+		d.Set("access_tier", "")
+	}
+
 	url := blob.GetURL()
 	if url == "" {
 		log.Printf("[INFO] URL for %q is empty", id.blobName)
@@ -656,7 +1015,8 @@ func resourceArmStorageBlobRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmStorageBlobDelete(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForDelete(armClient.StopContext, d)
+	defer cancel()
 
 	id, err := parseStorageBlobID(d.Id(), armClient.environment)
 	if err != nil {
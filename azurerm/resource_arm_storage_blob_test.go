@@ -2,14 +2,17 @@ package azurerm
 
 import (
 	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"os"
 	"testing"
 
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -143,6 +146,256 @@ func TestResourceAzureRMStorageBlobAttempts_validation(t *testing.T) {
 	}
 }
 
+func TestResourceAzureRMStorageBlobMetadata_validation(t *testing.T) {
+	cases := []struct {
+		Value    map[string]interface{}
+		ErrCount int
+	}{
+		{
+			Value:    map[string]interface{}{},
+			ErrCount: 0,
+		},
+		{
+			Value: map[string]interface{}{
+				"environment":   "production",
+				"_cost_centre1": "infrastructure",
+			},
+			ErrCount: 0,
+		},
+		{
+			Value: map[string]interface{}{
+				"Environment": "production",
+			},
+			ErrCount: 1,
+		},
+		{
+			Value: map[string]interface{}{
+				"1environment": "production",
+				"cost-centre":  "infrastructure",
+			},
+			ErrCount: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validateArmStorageBlobMetadata(tc.Value, "metadata")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %+v but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
+
+func TestResourceAzureRMStorageBlobSourceMD5(t *testing.T) {
+	sourceBlob, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+	defer os.Remove(sourceBlob.Name())
+
+	if _, err := sourceBlob.WriteString("Hello, World!"); err != nil {
+		t.Fatalf("Failed to write to source blob")
+	}
+	if err := sourceBlob.Close(); err != nil {
+		t.Fatalf("Failed to close source blob")
+	}
+
+	hash, err := resourceArmStorageBlobSourceMD5(sourceBlob.Name())
+	if err != nil {
+		t.Fatalf("Error calculating the MD5 hash: %+v", err)
+	}
+
+	expected := "65a8e27d8879283831b664bd8b7f0ad4"
+	if actual := hex.EncodeToString(hash); actual != expected {
+		t.Fatalf("Expected the MD5 hash to be %q but got %q", expected, actual)
+	}
+
+	if _, err := resourceArmStorageBlobSourceMD5(sourceBlob.Name() + "-missing"); err == nil {
+		t.Fatalf("Expected an error for a source file which doesn't exist")
+	}
+}
+
+func TestResourceAzureRMStorageBlobCustomizeDiff_contentMD5(t *testing.T) {
+	sourceBlob, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+	defer os.Remove(sourceBlob.Name())
+
+	if _, err := sourceBlob.WriteString("Hello, World!"); err != nil {
+		t.Fatalf("Failed to write to source blob")
+	}
+	if err := sourceBlob.Close(); err != nil {
+		t.Fatalf("Failed to close source blob")
+	}
+
+	cases := []struct {
+		Name             string
+		Type             string
+		ContentMD5       string
+		ExpectedReupload bool
+		ExpectedNewHash  string
+	}{
+		{
+			// e.g. a blob uploaded before the `content_md5` was tracked, or a Page Blob
+			Name:             "No Content-MD5",
+			Type:             "page",
+			ContentMD5:       "",
+			ExpectedReupload: false,
+		},
+		{
+			Name:             "Unchanged",
+			Type:             "block",
+			ContentMD5:       "65a8e27d8879283831b664bd8b7f0ad4",
+			ExpectedReupload: false,
+		},
+		{
+			Name:             "Changed",
+			Type:             "block",
+			ContentMD5:       "00000000000000000000000000000000",
+			ExpectedReupload: true,
+			ExpectedNewHash:  "65a8e27d8879283831b664bd8b7f0ad4",
+		},
+	}
+
+	for _, tc := range cases {
+		attributes := map[string]string{
+			"id":                     "https://example.blob.core.windows.net/example/example.vhd",
+			"name":                   "example.vhd",
+			"resource_group_name":    "example",
+			"storage_account_name":   "example",
+			"storage_container_name": "example",
+			"type":                   tc.Type,
+			"size":                   "0",
+			"content_type":           "application/octet-stream",
+			"source":                 sourceBlob.Name(),
+			"parallelism":            "8",
+			"attempts":               "1",
+			"access_tier":            "",
+			"content_md5":            tc.ContentMD5,
+		}
+		state := &terraform.InstanceState{
+			ID:         attributes["id"],
+			Attributes: attributes,
+		}
+
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"name":                   "example.vhd",
+			"resource_group_name":    "example",
+			"storage_account_name":   "example",
+			"storage_container_name": "example",
+			"type":                   tc.Type,
+			"source":                 sourceBlob.Name(),
+		})
+		if err != nil {
+			t.Fatalf("Error building the config for %q: %+v", tc.Name, err)
+		}
+
+		diff, err := resourceArmStorageBlob().Diff(state, terraform.NewResourceConfig(raw), nil)
+		if err != nil {
+			t.Fatalf("Error diffing %q: %+v", tc.Name, err)
+		}
+
+		var attr *terraform.ResourceAttrDiff
+		if diff != nil {
+			attr = diff.Attributes["content_md5"]
+		}
+
+		if !tc.ExpectedReupload {
+			if attr != nil {
+				t.Fatalf("Expected no diff for the `content_md5` of %q but got %+v", tc.Name, attr)
+			}
+			continue
+		}
+
+		if attr == nil || attr.New != tc.ExpectedNewHash {
+			t.Fatalf("Expected the `content_md5` of %q to change to %q but got %+v", tc.Name, tc.ExpectedNewHash, attr)
+		}
+	}
+}
+
+func TestResourceAzureRMStorageBlobCustomizeDiff_pageBlob(t *testing.T) {
+	cases := []struct {
+		Name          string
+		Config        map[string]interface{}
+		ExpectedError string
+	}{
+		{
+			Name:   "Page Blob",
+			Config: map[string]interface{}{"type": "page", "size": 5120},
+		},
+		{
+			Name:          "Page Blob with an Access Tier",
+			Config:        map[string]interface{}{"type": "page", "size": 5120, "access_tier": "Cool"},
+			ExpectedError: "`access_tier` can only be specified for `block` blobs",
+		},
+		{
+			Name:          "Page Blob with Source Content",
+			Config:        map[string]interface{}{"type": "page", "size": 5120, "source_content": "Hello, World!"},
+			ExpectedError: "`source_content` can only be specified for `block` blobs",
+		},
+		{
+			Name:   "Block Blob with an Access Tier and Source Content",
+			Config: map[string]interface{}{"type": "block", "access_tier": "Cool", "source_content": "Hello, World!"},
+		},
+	}
+
+	for _, tc := range cases {
+		values := map[string]interface{}{
+			"name":                   "example.vhd",
+			"resource_group_name":    "example",
+			"storage_account_name":   "example",
+			"storage_container_name": "example",
+		}
+		for k, v := range tc.Config {
+			values[k] = v
+		}
+
+		raw, err := config.NewRawConfig(values)
+		if err != nil {
+			t.Fatalf("Error building the config for %q: %+v", tc.Name, err)
+		}
+
+		_, err = resourceArmStorageBlob().Diff(nil, terraform.NewResourceConfig(raw), nil)
+		if tc.ExpectedError == "" {
+			if err != nil {
+				t.Fatalf("Expected no error for %q but got: %+v", tc.Name, err)
+			}
+			continue
+		}
+
+		if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+			t.Fatalf("Expected the error for %q to contain %q but got: %+v", tc.Name, tc.ExpectedError, err)
+		}
+	}
+}
+
+func TestFlattenStorageBlobContentMD5(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Input:    "ZajifYh5KDgxtmS9i38K1A==",
+			Expected: "65a8e27d8879283831b664bd8b7f0ad4",
+		},
+		{
+			Input:    "not-base64!",
+			Expected: "",
+		},
+	}
+
+	for _, tc := range cases {
+		if actual := flattenStorageBlobContentMD5(tc.Input); actual != tc.Expected {
+			t.Fatalf("Expected %q to be flattened to %q but got %q", tc.Input, tc.Expected, actual)
+		}
+	}
+}
+
 func TestAccAzureRMStorageBlob_basic(t *testing.T) {
 	resourceName := "azurerm_storage_blob.test"
 	ri := acctest.RandInt()
@@ -228,6 +481,98 @@ func TestAccAzureRMStorageBlobBlock_source(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMStorageBlobBlock_sourceChanged(t *testing.T) {
+	ri := acctest.RandInt()
+	rs1 := strings.ToLower(acctest.RandString(11))
+	sourceBlob, err := ioutil.TempFile("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+	defer os.Remove(sourceBlob.Name())
+
+	if _, err := io.CopyN(sourceBlob, rand.Reader, 5*1024*1024); err != nil {
+		t.Fatalf("Failed to write random test to source blob")
+	}
+	if err := sourceBlob.Close(); err != nil {
+		t.Fatalf("Failed to close source blob")
+	}
+
+	resourceName := "azurerm_storage_blob.source"
+	config := testAccAzureRMStorageBlobBlock_source(ri, rs1, sourceBlob.Name(), testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, storage.BlobTypeBlock, sourceBlob.Name()),
+					resource.TestCheckResourceAttrSet(resourceName, "content_md5"),
+				),
+			},
+			{
+				// the contents of the source file change, but the path doesn't
+				PreConfig: func() {
+					if err := ioutil.WriteFile(sourceBlob.Name(), []byte("Hello, World!"), 0644); err != nil {
+						t.Fatalf("Failed to update the source blob: %+v", err)
+					}
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobMatchesFile(resourceName, storage.BlobTypeBlock, sourceBlob.Name()),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "65a8e27d8879283831b664bd8b7f0ad4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageBlobBlock_sourceContent(t *testing.T) {
+	resourceName := "azurerm_storage_blob.test"
+	ri := acctest.RandInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageBlobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageBlobBlock_sourceContent(ri, rs, location, "Hello, World!", "Hot"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "65a8e27d8879283831b664bd8b7f0ad4"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "max-age=3600"),
+					resource.TestCheckResourceAttr(resourceName, "content_encoding", "identity"),
+					resource.TestCheckResourceAttr(resourceName, "content_disposition", "attachment; filename=example.txt"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.environment", "production"),
+					resource.TestCheckResourceAttr(resourceName, "access_tier", "Hot"),
+				),
+			},
+			{
+				Config: testAccAzureRMStorageBlobBlock_sourceContent(ri, rs, location, "Goodbye, World!", "Cool"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageBlobExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "content_md5", "f9f6239b4838b415083e81a29cbd312e"),
+					resource.TestCheckResourceAttr(resourceName, "cache_control", "max-age=3600"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.environment", "production"),
+					resource.TestCheckResourceAttr(resourceName, "access_tier", "Cool"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"attempts", "parallelism", "size", "source_content"},
+			},
+		},
+	})
+}
+
 func TestAccAzureRMStorageBlobPage_source(t *testing.T) {
 	resourceName := "azurerm_storage_blob.source"
 	ri := acctest.RandInt()
@@ -770,3 +1115,46 @@ resource "azurerm_storage_blob" "source" {
 }
 `, rInt, location, rString, sourceBlobName, contentType)
 }
+
+func testAccAzureRMStorageBlobBlock_sourceContent(rInt int, rString string, location string, content string, accessTier string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_kind             = "StorageV2"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "content"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  storage_account_name   = "${azurerm_storage_account.test.name}"
+  storage_container_name = "${azurerm_storage_container.test.name}"
+  type                   = "block"
+  source_content         = "%s"
+  content_type           = "text/plain"
+  cache_control          = "max-age=3600"
+  content_encoding       = "identity"
+  content_disposition    = "attachment; filename=example.txt"
+  access_tier            = "%s"
+
+  metadata {
+    environment = "production"
+  }
+}
+`, rInt, location, rString, content, accessTier)
+}
@@ -0,0 +1,204 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+)
+
+func TestUnitAzureRMSubnetNetworkSecurityGroupAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_subnet_network_security_group_association.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetNetworkSecurityGroupAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", "azurerm_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "network_security_group_id", "azurerm_network_security_group.test", "id"),
+					testCheckFakeSubnetAssociation(server, "azurerm_subnet.test", "networkSecurityGroup", "azurerm_network_security_group.test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// removing the Association should detach the Network Security Group from the Subnet
+				Config: testAccAzureRMSubnetNetworkSecurityGroupAssociation_template(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeSubnetAssociation(server, "azurerm_subnet.test", "networkSecurityGroup", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubnetNetworkSecurityGroupAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_subnet_network_security_group_association.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetNetworkSecurityGroupAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetNetworkSecurityGroupAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSubnetNetworkSecurityGroupAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		virtualNetworkName := id.Path["virtualNetworks"]
+		subnetName := id.Path["subnets"]
+
+		client := testAccProvider.Meta().(*ArmClient).subnetClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on subnetClient: %+v", err)
+		}
+
+		props := resp.SubnetPropertiesFormat
+		if props == nil || props.NetworkSecurityGroup == nil {
+			return fmt.Errorf("Bad: Subnet %q (Virtual Network %q / Resource Group %q) has no Network Security Group", subnetName, virtualNetworkName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+// testCheckFakeSubnetAssociation checks the Subnet stored in the fake Resource Manager API references the ID of the
+// Resource with the specified name via the `key` property - or nothing at all when `expected` is empty
+func testCheckFakeSubnetAssociation(server *fakearm.Server, subnetResourceName string, key string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[subnetResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", subnetResourceName)
+		}
+
+		subnet, exists := server.Get(rs.Primary.ID)
+		if !exists {
+			return fmt.Errorf("Bad: Subnet %q does not exist in the fake Resource Manager API", rs.Primary.ID)
+		}
+
+		actual := ""
+		if props, ok := subnet["properties"].(map[string]interface{}); ok {
+			if v, ok := props[key].(map[string]interface{}); ok {
+				actual, _ = v["id"].(string)
+			}
+		}
+
+		if expected == "" {
+			if actual != "" {
+				return fmt.Errorf("Bad: expected Subnet %q to have no %q but got %q", rs.Primary.ID, key, actual)
+			}
+
+			return nil
+		}
+
+		associated, ok := s.RootModule().Resources[expected]
+		if !ok {
+			return fmt.Errorf("Not found: %s", expected)
+		}
+
+		if !strings.EqualFold(actual, associated.Primary.ID) {
+			return fmt.Errorf("Bad: expected Subnet %q to have %q %q but got %q", rs.Primary.ID, key, associated.Primary.ID, actual)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMSubnetNetworkSecurityGroupAssociation_basic(rInt int, location string) string {
+	template := testAccAzureRMSubnetNetworkSecurityGroupAssociation_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_network_security_group_association" "test" {
+  subnet_id                 = "${azurerm_subnet.test.id}"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+}
+`, template)
+}
+
+func testAccAzureRMSubnetNetworkSecurityGroupAssociation_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+
+  lifecycle {
+    ignore_changes = ["network_security_group_id"]
+  }
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  security_rule {
+    name                       = "test123"
+    priority                   = 100
+    direction                  = "Inbound"
+    access                     = "Allow"
+    protocol                   = "Tcp"
+    source_port_range          = "*"
+    destination_port_range     = "*"
+    source_address_prefix      = "*"
+    destination_address_prefix = "*"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
@@ -0,0 +1,156 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+)
+
+func TestUnitAzureRMSubnetRouteTableAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_subnet_route_table_association.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetRouteTableAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "subnet_id", "azurerm_subnet.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_id", "azurerm_route_table.test", "id"),
+					testCheckFakeSubnetAssociation(server, "azurerm_subnet.test", "routeTable", "azurerm_route_table.test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// removing the Association should detach the Route Table from the Subnet
+				Config: testAccAzureRMSubnetRouteTableAssociation_template(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeSubnetAssociation(server, "azurerm_subnet.test", "routeTable", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubnetRouteTableAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_subnet_route_table_association.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubnetRouteTableAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubnetRouteTableAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSubnetRouteTableAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		virtualNetworkName := id.Path["virtualNetworks"]
+		subnetName := id.Path["subnets"]
+
+		client := testAccProvider.Meta().(*ArmClient).subnetClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on subnetClient: %+v", err)
+		}
+
+		props := resp.SubnetPropertiesFormat
+		if props == nil || props.RouteTable == nil {
+			return fmt.Errorf("Bad: Subnet %q (Virtual Network %q / Resource Group %q) has no Route Table", subnetName, virtualNetworkName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMSubnetRouteTableAssociation_basic(rInt int, location string) string {
+	template := testAccAzureRMSubnetRouteTableAssociation_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subnet_route_table_association" "test" {
+  subnet_id      = "${azurerm_subnet.test.id}"
+  route_table_id = "${azurerm_route_table.test.id}"
+}
+`, template)
+}
+
+func testAccAzureRMSubnetRouteTableAssociation_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+
+  lifecycle {
+    ignore_changes = ["route_table_id"]
+  }
+}
+
+resource "azurerm_route_table" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  route {
+    name                   = "acctest-%d"
+    address_prefix         = "10.100.0.0/14"
+    next_hop_type          = "VirtualAppliance"
+    next_hop_in_ip_address = "10.10.1.1"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -0,0 +1,99 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceArmSubscriptionTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubscriptionTemplateDeploymentCreate,
+		Read:   resourceArmSubscriptionTemplateDeploymentRead,
+		Update: resourceArmSubscriptionTemplateDeploymentUpdate,
+		Delete: resourceArmSubscriptionTemplateDeploymentDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(180 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// the location the Deployment's metadata is stored in
+			"location": locationSchema(),
+
+			"template_body": {
+				Type:      schema.TypeString,
+				Required:  true,
+				StateFunc: normalizeJson,
+			},
+
+			"parameters": {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"parameters_body"},
+			},
+
+			"parameters_body": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				StateFunc:     normalizeJson,
+				ConflictsWith: []string{"parameters"},
+			},
+
+			"outputs": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmSubscriptionTemplateDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	scope := fmt.Sprintf("/subscriptions/%s", meta.(*ArmClient).subscriptionId)
+	if err := templateDeploymentAtScopeDeploy(d, meta, scope); err != nil {
+		return err
+	}
+
+	return resourceArmSubscriptionTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmSubscriptionTemplateDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	if templateDeploymentAtScopeUpdateRequired(d) {
+		id, err := parseTemplateDeploymentAtScopeId(d.Id())
+		if err != nil {
+			return err
+		}
+
+		if err := templateDeploymentAtScopeDeploy(d, meta, id.Scope); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmSubscriptionTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmSubscriptionTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	_, err := templateDeploymentAtScopeRead(d, meta)
+	return err
+}
+
+func resourceArmSubscriptionTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	return templateDeploymentAtScopeDelete(d, meta)
+}
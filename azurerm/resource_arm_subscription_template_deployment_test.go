@@ -0,0 +1,231 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
+)
+
+func TestParseTemplateDeploymentAtScopeId(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected *templateDeploymentAtScopeId
+	}{
+		{
+			Input: "",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000",
+		},
+		{
+			Input: "/providers/Microsoft.Resources/deployments/example",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Resources/deployments/",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Resources/deployments/example/operations/1",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Resources/deployments/example",
+			Expected: &templateDeploymentAtScopeId{
+				Scope: "/subscriptions/00000000-0000-0000-0000-000000000000",
+				Name:  "example",
+			},
+		},
+		{
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/microsoft.resources/deployments/example",
+			Expected: &templateDeploymentAtScopeId{
+				Scope: "/providers/Microsoft.Management/managementGroups/group1",
+				Name:  "example",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := parseTemplateDeploymentAtScopeId(tc.Input)
+		if tc.Expected == nil {
+			if err == nil {
+				t.Fatalf("Expected an error parsing %q but didn't get one", tc.Input)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error parsing %q but got: %+v", tc.Input, err)
+		}
+		if *actual != *tc.Expected {
+			t.Fatalf("Expected %+v for %q but got %+v", *tc.Expected, tc.Input, *actual)
+		}
+	}
+}
+
+func TestUnitAzureRMSubscriptionTemplateDeployment_update(t *testing.T) {
+	resourceName := "azurerm_subscription_template_deployment.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+	testFakeTemplateDeploymentOutputs(server)
+
+	deploymentId := fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Resources/deployments/acctesttemplate-%d", fakearm.SubscriptionID, ri)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubscriptionTemplateDeployment_outputs(ri, location, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "id", deploymentId),
+					resource.TestCheckResourceAttr(resourceName, "location", location),
+					resource.TestCheckResourceAttr(resourceName, "outputs.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.greeting", "first"),
+					resource.TestCheckResourceAttr(resourceName, "outputs_json", `{"greeting":"first","tags":{"environment":"Production"}}`),
+				),
+			},
+			{
+				// changing the Template redeploys it in-place
+				Config: testAccAzureRMSubscriptionTemplateDeployment_outputs(ri, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", deploymentId),
+					resource.TestCheckResourceAttr(resourceName, "outputs.greeting", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubscriptionTemplateDeployment_basic(t *testing.T) {
+	resourceName := "azurerm_subscription_template_deployment.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTemplateDeploymentAtScopeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubscriptionTemplateDeployment_outputs(ri, location, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentAtScopeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.greeting", "first"),
+				),
+			},
+			{
+				Config: testAccAzureRMSubscriptionTemplateDeployment_outputs(ri, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTemplateDeploymentAtScopeExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.greeting", "second"),
+				),
+			},
+		},
+	})
+}
+
+// testFakeTemplateDeploymentOutputs returns the outputs of Template Deployments as specified in the Template,
+// since the fake API doesn't evaluate Templates
+func testFakeTemplateDeploymentOutputs(server *fakearm.Server) {
+	server.Computed("Microsoft.Resources/deployments", func(deployment map[string]interface{}) {
+		properties := deployment["properties"].(map[string]interface{})
+		template := properties["template"].(map[string]interface{})
+		outputs := make(map[string]interface{})
+		for k, v := range template["outputs"].(map[string]interface{}) {
+			outputs[k] = v
+		}
+		properties["outputs"] = outputs
+	})
+}
+
+func testCheckAzureRMTemplateDeploymentAtScopeExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := parseTemplateDeploymentAtScopeId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).deploymentsAtScopeClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.Scope, id.Name)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Template Deployment %q (Scope %q) does not exist", id.Name, id.Scope)
+			}
+
+			return fmt.Errorf("Bad: Get on deploymentsAtScopeClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMTemplateDeploymentAtScopeDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).deploymentsAtScopeClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_subscription_template_deployment" && rs.Type != "azurerm_management_group_template_deployment" {
+			continue
+		}
+
+		id, err := parseTemplateDeploymentAtScopeId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.Scope, id.Name)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Template Deployment still exists:\n%#v", resp.Properties)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSubscriptionTemplateDeployment_outputs(rInt int, location string, greeting string) string {
+	return fmt.Sprintf(`
+resource "azurerm_subscription_template_deployment" "test" {
+  name     = "acctesttemplate-%d"
+  location = "%s"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [],
+  "outputs": {
+    "greeting": {
+      "type": "string",
+      "value": "%s"
+    },
+    "tags": {
+      "type": "object",
+      "value": {
+        "environment": "Production"
+      }
+    }
+  }
+}
+DEPLOY
+}
+`, rInt, location, greeting)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -20,9 +22,18 @@ func resourceArmTemplateDeployment() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmTemplateDeploymentCreate,
 		Read:   resourceArmTemplateDeploymentRead,
-		Update: resourceArmTemplateDeploymentCreate,
+		Update: resourceArmTemplateDeploymentUpdate,
 		Delete: resourceArmTemplateDeploymentDelete,
 
+		CustomizeDiff: resourceArmTemplateDeploymentCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(180 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -62,73 +73,123 @@ func resourceArmTemplateDeployment() *schema.Resource {
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
+			"detect_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"outputs": {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
-		},
-	}
-}
 
-func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient)
-	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 
-	name := d.Get("name").(string)
-	resourceGroup := d.Get("resource_group_name").(string)
-	deploymentMode := d.Get("deployment_mode").(string)
+			"drifted_resource_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 
-	log.Printf("[INFO] preparing arguments for AzureRM Template Deployment creation.")
-	properties := resources.DeploymentProperties{
-		Mode: resources.DeploymentMode(deploymentMode),
+			"planned_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
 	}
+}
 
-	if v, ok := d.GetOk("parameters"); ok {
-		params := v.(map[string]interface{})
-
-		newParams := make(map[string]interface{}, len(params))
-		for key, val := range params {
-			newParams[key] = struct {
-				Value interface{}
-			}{
-				Value: val,
+// resourceArmTemplateDeploymentCustomizeDiff validates the Template when it's going to be deployed, and forces the
+// Template to be redeployed when any of the resources it deployed were found to be missing (when `detect_drift` is enabled)
+func resourceArmTemplateDeploymentCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	drifted := false
+	if d.Id() != "" && d.Get("detect_drift").(bool) {
+		if v := d.Get("drifted_resource_ids").([]interface{}); len(v) > 0 {
+			log.Printf("[DEBUG] Template Deployment %q has drifted - redeploying", d.Id())
+			for _, key := range []string{"outputs", "outputs_json", "drifted_resource_ids"} {
+				if err := d.SetNewComputed(key); err != nil {
+					return err
+				}
 			}
+			drifted = true
 		}
+	}
 
-		properties.Parameters = &newParams
+	deploying := d.Id() == "" || drifted
+	for _, key := range []string{"name", "resource_group_name", "template_body", "parameters", "parameters_body", "deployment_mode"} {
+		deploying = deploying || d.HasChange(key)
+	}
+	if !deploying {
+		return nil
 	}
 
-	if v, ok := d.GetOk("parameters_body"); ok {
-		params, err := expandParametersBody(v.(string))
-		if err != nil {
-			return err
-		}
+	return templateDeploymentPreviewDiff(d, meta)
+}
 
-		properties.Parameters = &params
+func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourceArmTemplateDeploymentDeploy(d, meta)
+}
+
+func resourceArmTemplateDeploymentUpdate(d *schema.ResourceData, meta interface{}) error {
+	// deployments are idempotent, so the Template is redeployed in-place when the Template or Parameters change
+	// (or when any of the resources it deployed have drifted) - rather than deleting the Deployment, which
+	// wouldn't delete the resources it deployed
+	//
+	// the `drifted_resource_ids` are marked as computed during the plan when the deployment has drifted, so
+	// the drifted resources are determined from the previous value (as found during the refresh)
+	oldDrifted, _ := d.GetChange("drifted_resource_ids")
+	drifted := len(oldDrifted.([]interface{})) > 0 && d.Get("detect_drift").(bool)
+	if !drifted && !d.HasChange("template_body") && !d.HasChange("parameters") && !d.HasChange("parameters_body") && !d.HasChange("deployment_mode") {
+		return resourceArmTemplateDeploymentRead(d, meta)
 	}
 
-	if v, ok := d.GetOk("template_body"); ok {
-		template, err := expandTemplateBody(v.(string))
-		if err != nil {
-			return err
-		}
+	return resourceArmTemplateDeploymentDeploy(d, meta)
+}
 
-		properties.Template = &template
+func resourceArmTemplateDeploymentDeploy(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deployClient := client.deploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	deploymentMode := d.Get("deployment_mode").(string)
+
+	log.Printf("[INFO] preparing arguments for AzureRM Template Deployment %q (Resource Group %q).", name, resourceGroup)
+	properties, err := expandArmTemplateDeploymentProperties(deploymentMode, d.Get("template_body").(string), d.Get("parameters").(map[string]interface{}), d.Get("parameters_body").(string))
+	if err != nil {
+		return err
 	}
 
 	deployment := resources.Deployment{
-		Properties: &properties,
+		Properties: properties,
 	}
 
 	future, err := deployClient.CreateOrUpdate(ctx, resourceGroup, name, deployment)
 	if err != nil {
-		return fmt.Errorf("Error creating deployment: %+v", err)
+		return fmt.Errorf("Error deploying Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
 	err = future.WaitForCompletionRef(ctx, deployClient.Client)
 	if err != nil {
-		return fmt.Errorf("Error creating deployment: %+v", err)
+		return fmt.Errorf("Error waiting for deployment of Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
 	read, err := deployClient.Get(ctx, resourceGroup, name)
@@ -147,7 +208,8 @@ func resourceArmTemplateDeploymentCreate(d *schema.ResourceData, meta interface{
 func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -168,8 +230,38 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error making Read request on Azure RM Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	outputs, outputsJson, err := flattenTemplateDeploymentOutputs(resp.Properties.Outputs)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("outputs", outputs); err != nil {
+		return fmt.Errorf("Error setting `outputs`: %+v", err)
+	}
+	d.Set("outputs_json", outputsJson)
+
+	drifted := make([]string, 0)
+	if d.Get("detect_drift").(bool) {
+		drifted, err = templateDeploymentDriftedResources(ctx, client, resourceGroup, name)
+		if err != nil {
+			return err
+		}
+		if len(drifted) > 0 {
+			log.Printf("[WARN] The following resources deployed by Template Deployment %q (Resource Group %q) no longer exist: %s", name, resourceGroup, strings.Join(drifted, ", "))
+		}
+	}
+	if err := d.Set("drifted_resource_ids", drifted); err != nil {
+		return fmt.Errorf("Error setting `drifted_resource_ids`: %+v", err)
+	}
+
+	return nil
+}
+
+// flattenTemplateDeploymentOutputs returns the Outputs of a Template Deployment which can be represented as strings
+// (bool's, int's and string's) along with all of the Outputs serialized as JSON
+func flattenTemplateDeploymentOutputs(outs interface{}) (map[string]string, string, error) {
 	outputs := make(map[string]string, 0)
-	if outs := resp.Properties.Outputs; outs != nil {
+	outputsTyped := make(map[string]interface{}, 0)
+	if outs != nil {
 		outsVal := outs.(map[string]interface{})
 		if len(outsVal) > 0 {
 			for key, output := range outsVal {
@@ -180,6 +272,8 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 					log.Printf("[DEBUG] No value - skipping")
 					continue
 				}
+				outputsTyped[key] = outputValue
+
 				outputType, ok := outputMap["type"]
 				if !ok {
 					log.Printf("[DEBUG] No type - skipping")
@@ -198,7 +292,7 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 					outputValueString = fmt.Sprint(outputValue)
 
 				default:
-					log.Printf("[WARN] Ignoring output %s: Outputs of type %s are not currently supported in azurerm_template_deployment.",
+					log.Printf("[WARN] Ignoring output %s: Outputs of type %s are only available in the `outputs_json` attribute of azurerm_template_deployment.",
 						key, outputType)
 					continue
 				}
@@ -207,13 +301,62 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return d.Set("outputs", outputs)
+	outputsJson, err := json.Marshal(outputsTyped)
+	if err != nil {
+		return nil, "", fmt.Errorf("Error serializing `outputs_json`: %+v", err)
+	}
+
+	return outputs, string(outputsJson), nil
+}
+
+// templateDeploymentDriftedResources returns the ID's of the top-level resources deployed by the Template Deployment
+// which no longer exist. Resources are looked up by listing the resources in their Resource Group, since this
+// (unlike retrieving each resource) doesn't require knowing the API Version for each type of resource.
+func templateDeploymentDriftedResources(ctx context.Context, client *ArmClient, resourceGroup, name string) ([]string, error) {
+	ids, err := templateDeploymentDeployedResourceIds(ctx, client, resourceGroup, name)
+	if err != nil {
+		return nil, err
+	}
+
+	deployed := make(map[string][]string)
+	for _, v := range ids {
+		if id, err := parseAzureResourceID(v); err == nil {
+			deployed[id.ResourceGroup] = append(deployed[id.ResourceGroup], v)
+		}
+	}
+
+	drifted := make([]string, 0)
+	for group, ids := range deployed {
+		existing := make(map[string]struct{})
+		resources, err := client.resourcesClient.ListByResourceGroupComplete(ctx, group, "", "", nil)
+		if err != nil && !utils.ResponseWasNotFound(resources.Response().Response) {
+			return nil, fmt.Errorf("Error listing Resources in Resource Group %q: %+v", group, err)
+		}
+		for err == nil && resources.NotDone() {
+			if v := resources.Value().ID; v != nil {
+				existing[strings.ToLower(*v)] = struct{}{}
+			}
+			if err := resources.Next(); err != nil {
+				return nil, fmt.Errorf("Error listing Resources in Resource Group %q: %+v", group, err)
+			}
+		}
+
+		for _, id := range ids {
+			if _, ok := existing[strings.ToLower(id)]; !ok {
+				drifted = append(drifted, id)
+			}
+		}
+	}
+
+	sort.Strings(drifted)
+	return drifted, nil
 }
 
 func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -233,6 +376,45 @@ func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{
 	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name)
 }
 
+func expandArmTemplateDeploymentProperties(deploymentMode string, templateBody string, parameters map[string]interface{}, parametersBody string) (*resources.DeploymentProperties, error) {
+	properties := resources.DeploymentProperties{
+		Mode: resources.DeploymentMode(deploymentMode),
+	}
+
+	if len(parameters) > 0 {
+		newParams := make(map[string]interface{}, len(parameters))
+		for key, val := range parameters {
+			newParams[key] = struct {
+				Value interface{}
+			}{
+				Value: val,
+			}
+		}
+
+		properties.Parameters = &newParams
+	}
+
+	if parametersBody != "" {
+		params, err := expandParametersBody(parametersBody)
+		if err != nil {
+			return nil, err
+		}
+
+		properties.Parameters = &params
+	}
+
+	if templateBody != "" {
+		template, err := expandTemplateBody(templateBody)
+		if err != nil {
+			return nil, err
+		}
+
+		properties.Template = &template
+	}
+
+	return &properties, nil
+}
+
 // TODO: move this out into the new `helpers` structure
 func expandParametersBody(body string) (map[string]interface{}, error) {
 	var parametersBody map[string]interface{}
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
 )
 
 func TestAccAzureRMTemplateDeployment_basic(t *testing.T) {
@@ -132,6 +135,233 @@ func TestAccAzureRMTemplateDeployment_withError(t *testing.T) {
 	})
 }
 
+func TestUnitAzureRMTemplateDeployment_updateAndDrift(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	// the fake API doesn't evaluate Templates, so the outputs are returned as specified in the Template
+	server.Computed("Microsoft.Resources/deployments", func(deployment map[string]interface{}) {
+		properties := deployment["properties"].(map[string]interface{})
+		template := properties["template"].(map[string]interface{})
+		outputs := make(map[string]interface{})
+		for k, v := range template["outputs"].(map[string]interface{}) {
+			outputs[k] = v
+		}
+		properties["outputs"] = outputs
+	})
+
+	resourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d", fakearm.SubscriptionID, ri)
+	deploymentId := fmt.Sprintf("%s/providers/Microsoft.Resources/deployments/acctesttemplate-%d", resourceGroupId, ri)
+	storageAccountId := fmt.Sprintf("%s/providers/Microsoft.Storage/storageAccounts/acctestsa%d", resourceGroupId, ri)
+
+	// the Storage Account within the Template is (re)created each time the Template is deployed
+	deployments := 0
+	server.SideEffect("Microsoft.Resources/deployments", func(deployment map[string]interface{}, put func(string, map[string]interface{})) {
+		deployments++
+		put(storageAccountId, map[string]interface{}{"location": location})
+	})
+	deploymentsBeforeRedeploy := 0
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTemplateDeployment_typedOutputs(ri, location, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "outputs.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.greeting", "first"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "outputs_json", `{"count":3,"greeting":"first","tags":{"environment":"Production"},"zones":["1","2"]}`),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resource_ids.#", "0"),
+				),
+			},
+			{
+				// changing the Template redeploys it in-place
+				Config: testAccAzureRMTemplateDeployment_typedOutputs(ri, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", deploymentId),
+					resource.TestCheckResourceAttr(resourceName, "outputs.greeting", "second"),
+				),
+			},
+			{
+				// a resource deployed by the Template has been deleted outside of Terraform
+				PreConfig: func() {
+					server.Delete(storageAccountId)
+
+					// the Deployment Operations API isn't nested beneath the `providers` segment
+					server.Put(fmt.Sprintf("%s/deployments/acctesttemplate-%d/operations/0123456789", resourceGroupId, ri), map[string]interface{}{
+						"properties": map[string]interface{}{
+							"targetResource": map[string]interface{}{
+								"id":           storageAccountId,
+								"resourceType": "Microsoft.Storage/storageAccounts",
+							},
+						},
+					})
+				},
+				Config:             testAccAzureRMTemplateDeployment_typedOutputs(ri, location, "second"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// applying redeploys the Template, after which there's no diff
+				PreConfig: func() {
+					deploymentsBeforeRedeploy = deployments
+				},
+				Config: testAccAzureRMTemplateDeployment_typedOutputs(ri, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						if deployments != deploymentsBeforeRedeploy+1 {
+							return fmt.Errorf("Bad: expected the Template to be redeployed once but it was redeployed %d times", deployments-deploymentsBeforeRedeploy)
+						}
+						if _, exists := server.Get(storageAccountId); !exists {
+							return fmt.Errorf("Bad: expected the Storage Account %q to have been redeployed", storageAccountId)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(resourceName, "drifted_resource_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitAzureRMTemplateDeployment_plannedChanges(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	// much like the real API, resources without an API Version are rejected
+	server.RequestAction("Microsoft.Resources/deployments", "validate", func(id string, body map[string]interface{}) (int, interface{}) {
+		properties := body["properties"].(map[string]interface{})
+		template := properties["template"].(map[string]interface{})
+		for _, v := range template["resources"].([]interface{}) {
+			if _, ok := v.(map[string]interface{})["apiVersion"]; !ok {
+				return http.StatusBadRequest, map[string]interface{}{
+					"error": map[string]interface{}{
+						"code":    "InvalidTemplate",
+						"message": "Deployment template validation failed: 'The template resource is missing the apiVersion'.",
+					},
+				}
+			}
+		}
+
+		return http.StatusOK, map[string]interface{}{
+			"id":         id,
+			"properties": properties,
+		}
+	})
+
+	resourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d", fakearm.SubscriptionID, ri)
+	publicIPId := fmt.Sprintf("%s/providers/Microsoft.Network/publicIPAddresses/acctestpip-%d", resourceGroupId, ri)
+	storageAccountId := fmt.Sprintf("%s/providers/Microsoft.Storage/storageAccounts/acctestsa%d", resourceGroupId, ri)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTemplateDeployment_plannedChanges(ri, location, "Incremental", true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.0.action", "Create"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.0.resource_id", publicIPId),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.1.action", "Create"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.1.resource_id", storageAccountId),
+				),
+			},
+			{
+				// the Public IP is removed from the Template, which deletes it in Complete mode
+				PreConfig: func() {
+					for i, id := range []string{publicIPId, storageAccountId} {
+						server.Put(fmt.Sprintf("%s/deployments/acctesttemplate-%d/operations/%d", resourceGroupId, ri, i), map[string]interface{}{
+							"properties": map[string]interface{}{
+								"targetResource": map[string]interface{}{
+									"id": id,
+								},
+							},
+						})
+					}
+				},
+				Config: testAccAzureRMTemplateDeployment_plannedChanges(ri, location, "Complete", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.0.action", "Delete"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.0.resource_id", publicIPId),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.1.action", "Modify"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.1.resource_id", storageAccountId),
+				),
+			},
+			{
+				// errors in the Template are returned during the plan
+				Config:      strings.Replace(testAccAzureRMTemplateDeployment_plannedChanges(ri, location, "Complete", false), `"apiVersion": "2017-10-01",`, "", -1),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("InvalidTemplate: Deployment template validation failed"),
+			},
+		},
+	})
+}
+
+func TestUnitAzureRMTemplateDeployment_interpolatedParameters(t *testing.T) {
+	resourceName := "azurerm_template_deployment.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	// parameters which aren't known yet mustn't be sent to the API to be validated
+	server.RequestAction("Microsoft.Resources/deployments", "validate", func(id string, body map[string]interface{}) (int, interface{}) {
+		properties := body["properties"].(map[string]interface{})
+		parameters := properties["parameters"].(map[string]interface{})
+		for name, v := range parameters {
+			if value := v.(map[string]interface{})["value"]; value == config.UnknownVariableValue {
+				return http.StatusBadRequest, map[string]interface{}{
+					"error": map[string]interface{}{
+						"code":    "InvalidTemplate",
+						"message": fmt.Sprintf("Deployment template validation failed: the value of the Parameter %q is invalid.", name),
+					},
+				}
+			}
+		}
+
+		return http.StatusOK, map[string]interface{}{
+			"id":         id,
+			"properties": properties,
+		}
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMTemplateDeployment_interpolatedParameters(ri, location, fmt.Sprintf("acctestsa%d", ri), false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "1"),
+				),
+			},
+			{
+				// the Parameter is interpolated from a Resource Group which doesn't exist yet
+				Config: testAccAzureRMTemplateDeployment_interpolatedParameters(ri, location, "${substr(md5(azurerm_resource_group.other.id), 0, 16)}", true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckFakeResourceExists(server, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMTemplateDeploymentExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -743,3 +973,157 @@ DEPLOY
   }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMTemplateDeployment_typedOutputs(rInt int, location string, greeting string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+  detect_drift        = true
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [],
+  "outputs": {
+    "greeting": {
+      "type": "string",
+      "value": "%s"
+    },
+    "count": {
+      "type": "int",
+      "value": 3
+    },
+    "zones": {
+      "type": "array",
+      "value": ["1", "2"]
+    },
+    "tags": {
+      "type": "object",
+      "value": {
+        "environment": "Production"
+      }
+    }
+  }
+}
+DEPLOY
+}
+`, rInt, location, rInt, greeting)
+}
+
+func testAccAzureRMTemplateDeployment_plannedChanges(rInt int, location string, deploymentMode string, includePublicIP bool) string {
+	publicIP := ""
+	if includePublicIP {
+		publicIP = fmt.Sprintf(`,
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "name": "acctestpip-%d",
+      "apiVersion": "2017-10-01",
+      "location": "[resourceGroup().location]",
+      "properties": {
+        "publicIPAllocationMethod": "Dynamic"
+      }
+    }`, rInt)
+	}
+
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "%s"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "storageAccountName": {
+      "type": "string"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Storage/storageAccounts",
+      "name": "[parameters('storageAccountName')]",
+      "apiVersion": "2017-10-01",
+      "location": "[resourceGroup().location]",
+      "sku": {
+        "name": "Standard_LRS"
+      },
+      "kind": "StorageV2"
+    }%s
+  ]
+}
+DEPLOY
+
+  parameters {
+    storageAccountName = "acctestsa%d"
+  }
+}
+`, rInt, location, rInt, deploymentMode, publicIP, rInt)
+}
+
+func testAccAzureRMTemplateDeployment_interpolatedParameters(rInt int, location string, storageAccountName string, includeOtherResourceGroup bool) string {
+	otherResourceGroup := ""
+	if includeOtherResourceGroup {
+		otherResourceGroup = fmt.Sprintf(`
+resource "azurerm_resource_group" "other" {
+  name     = "acctestRG-other-%d"
+  location = "%s"
+}
+`, rInt, location)
+	}
+
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+%s
+resource "azurerm_template_deployment" "test" {
+  name                = "acctesttemplate-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "storageAccountName": {
+      "type": "string"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Storage/storageAccounts",
+      "name": "[parameters('storageAccountName')]",
+      "apiVersion": "2017-10-01",
+      "location": "[resourceGroup().location]",
+      "sku": {
+        "name": "Standard_LRS"
+      },
+      "kind": "StorageV2"
+    }
+  ]
+}
+DEPLOY
+
+  parameters {
+    storageAccountName = "%s"
+  }
+}
+`, rInt, location, otherResourceGroup, rInt, storageAccountName)
+}
@@ -2,17 +2,22 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -26,6 +31,13 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -120,6 +132,56 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				}, true),
 			},
 
+			"rolling_upgrade_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_batch_instance_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      20,
+							ValidateFunc: validation.IntBetween(5, 100),
+						},
+
+						"max_unhealthy_instance_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      20,
+							ValidateFunc: validation.IntBetween(5, 100),
+						},
+
+						"max_unhealthy_upgraded_instance_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      20,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+
+						"pause_time_between_batches": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "PT0S",
+							ValidateFunc: validateIso8601Duration(),
+						},
+					},
+				},
+			},
+
+			"health_probe_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"automatic_os_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"overprovision": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -663,14 +725,15 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 
 func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine Scale Set creation.")
 
 	name := d.Get("name").(string)
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	resGroup := d.Get("resource_group_name").(string)
-	tags := d.Get("tags").(map[string]interface{})
+	tags := mergeDefaultTags(meta, d.Get("tags").(map[string]interface{}))
 	zones := expandZones(d.Get("zones").([]interface{}))
 
 	sku, err := expandVirtualMachineScaleSetSku(d)
@@ -712,16 +775,32 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 	}
 
 	updatePolicy := d.Get("upgrade_policy_mode").(string)
+	automaticOSUpgrade := d.Get("automatic_os_upgrade").(bool)
 	overprovision := d.Get("overprovision").(bool)
 	singlePlacementGroup := d.Get("single_placement_group").(bool)
 	priority := d.Get("priority").(string)
 
+	upgradePolicy := compute.UpgradePolicy{
+		Mode:               compute.UpgradeMode(updatePolicy),
+		AutomaticOSUpgrade: utils.Bool(automaticOSUpgrade),
+	}
+
+	rollingUpgrade := strings.EqualFold(updatePolicy, string(compute.Rolling))
+	if rollingUpgrade {
+		upgradePolicy.RollingUpgradePolicy = expandAzureRmVirtualMachineScaleSetRollingUpgradePolicy(d)
+	}
+
+	networkProfile := expandAzureRmVirtualMachineScaleSetNetworkProfile(d)
+	if v := d.Get("health_probe_id").(string); v != "" {
+		networkProfile.HealthProbe = &compute.APIEntityReference{
+			ID: utils.String(v),
+		}
+	}
+
 	scaleSetProps := compute.VirtualMachineScaleSetProperties{
-		UpgradePolicy: &compute.UpgradePolicy{
-			Mode: compute.UpgradeMode(updatePolicy),
-		},
+		UpgradePolicy: &upgradePolicy,
 		VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
-			NetworkProfile:   expandAzureRmVirtualMachineScaleSetNetworkProfile(d),
+			NetworkProfile:   networkProfile,
 			StorageProfile:   &storageProfile,
 			OsProfile:        osProfile,
 			ExtensionProfile: extensions,
@@ -762,6 +841,18 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 		properties.Plan = plan
 	}
 
+	// when the Upgrade Policy is `Rolling` Azure upgrades the existing instances to the new model in batches - as such
+	// when the image changes we wait for this Rolling Upgrade to complete, rather than leaving instances on the old image
+	waitForRollingUpgrade := !d.IsNewResource() && rollingUpgrade && d.HasChange("storage_profile_image_reference") && d.Get("sku.0.capacity").(int) > 0
+	var previousRollingUpgrade *string
+	if waitForRollingUpgrade {
+		rollingUpgradesClient := meta.(*ArmClient).rollingUpgradesClient
+		previousRollingUpgrade, err = retrieveAzureRMVirtualMachineScaleSetLatestRollingUpgrade(ctx, rollingUpgradesClient, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the latest Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, properties)
 	if err != nil {
 		return err
@@ -771,6 +862,20 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	if waitForRollingUpgrade {
+		log.Printf("[DEBUG] Waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to complete..", name, resGroup)
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"Pending", string(compute.RollingUpgradeStatusCodeRollingForward)},
+			Target:  []string{string(compute.RollingUpgradeStatusCodeCompleted)},
+			Refresh: virtualMachineScaleSetRollingUpgradeStateRefreshFunc(ctx, meta.(*ArmClient).rollingUpgradesClient, resGroup, name, previousRollingUpgrade),
+			Timeout: d.Timeout(schema.TimeoutUpdate),
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to complete: %+v", name, resGroup, err)
+		}
+	}
+
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		return err
@@ -786,7 +891,8 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 
 func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -825,6 +931,11 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 
 		if upgradePolicy := properties.UpgradePolicy; upgradePolicy != nil {
 			d.Set("upgrade_policy_mode", upgradePolicy.Mode)
+			d.Set("automatic_os_upgrade", upgradePolicy.AutomaticOSUpgrade)
+
+			if err := d.Set("rolling_upgrade_policy", flattenAzureRmVirtualMachineScaleSetRollingUpgradePolicy(upgradePolicy.RollingUpgradePolicy)); err != nil {
+				return fmt.Errorf("[DEBUG] Error setting `rolling_upgrade_policy`: %#v", err)
+			}
 		}
 		d.Set("overprovision", properties.Overprovision)
 		d.Set("single_placement_group", properties.SinglePlacementGroup)
@@ -873,6 +984,12 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 			}
 
 			if networkProfile := profile.NetworkProfile; networkProfile != nil {
+				healthProbeId := ""
+				if probe := networkProfile.HealthProbe; probe != nil && probe.ID != nil {
+					healthProbeId = *probe.ID
+				}
+				d.Set("health_probe_id", healthProbeId)
+
 				flattenedNetworkProfile := flattenAzureRmVirtualMachineScaleSetNetworkProfile(networkProfile)
 				if err := d.Set("network_profile", flattenedNetworkProfile); err != nil {
 					return fmt.Errorf("[DEBUG] Error setting `network_profile`: %#v", err)
@@ -921,14 +1038,15 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, meta, resp.Tags)
 
 	return nil
 }
 
 func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -949,6 +1067,61 @@ func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interf
 	return nil
 }
 
+func retrieveAzureRMVirtualMachineScaleSetLatestRollingUpgrade(ctx context.Context, client compute.VirtualMachineScaleSetRollingUpgradesClient, resGroup string, name string) (*string, error) {
+	resp, err := client.GetLatest(ctx, resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return virtualMachineScaleSetRollingUpgradeStartTime(resp), nil
+}
+
+// virtualMachineScaleSetRollingUpgradeStateRefreshFunc returns the status of the latest Rolling Upgrade, providing it
+// was started after the specified Rolling Upgrade - otherwise it's `Pending`, since Azure hasn't started it yet
+func virtualMachineScaleSetRollingUpgradeStateRefreshFunc(ctx context.Context, client compute.VirtualMachineScaleSetRollingUpgradesClient, resGroup string, name string, previous *string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetLatest(ctx, resGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "Pending", nil
+			}
+
+			return nil, "", fmt.Errorf("Error retrieving the latest Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		startTime := virtualMachineScaleSetRollingUpgradeStartTime(resp)
+		if startTime == nil || (previous != nil && *startTime == *previous) {
+			return resp, "Pending", nil
+		}
+
+		code := resp.RollingUpgradeStatusInfoProperties.RunningStatus.Code
+		switch code {
+		case compute.RollingUpgradeStatusCodeCancelled, compute.RollingUpgradeStatusCodeFaulted:
+			message := ""
+			if apiError := resp.RollingUpgradeStatusInfoProperties.Error; apiError != nil && apiError.Message != nil {
+				message = *apiError.Message
+			}
+			return resp, string(code), fmt.Errorf("The Rolling Upgrade was %s: %s", code, message)
+		}
+
+		return resp, string(code), nil
+	}
+}
+
+func virtualMachineScaleSetRollingUpgradeStartTime(input compute.RollingUpgradeStatusInfo) *string {
+	if props := input.RollingUpgradeStatusInfoProperties; props != nil {
+		if status := props.RunningStatus; status != nil && status.StartTime != nil {
+			return utils.String(status.StartTime.String())
+		}
+	}
+
+	return nil
+}
+
 func flattenAzureRmVirtualMachineScaleSetIdentity(identity *compute.VirtualMachineScaleSetIdentity) []interface{} {
 	if identity == nil {
 		return make([]interface{}, 0)
@@ -1272,6 +1445,28 @@ func flattenAzureRmVirtualMachineScaleSetStorageProfileImageReference(image *com
 	return []interface{}{result}
 }
 
+func flattenAzureRmVirtualMachineScaleSetRollingUpgradePolicy(policy *compute.RollingUpgradePolicy) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	if v := policy.MaxBatchInstancePercent; v != nil {
+		result["max_batch_instance_percent"] = int(*v)
+	}
+	if v := policy.MaxUnhealthyInstancePercent; v != nil {
+		result["max_unhealthy_instance_percent"] = int(*v)
+	}
+	if v := policy.MaxUnhealthyUpgradedInstancePercent; v != nil {
+		result["max_unhealthy_upgraded_instance_percent"] = int(*v)
+	}
+	if v := policy.PauseTimeBetweenBatches; v != nil {
+		result["pause_time_between_batches"] = *v
+	}
+
+	return []interface{}{result}
+}
+
 func flattenAzureRmVirtualMachineScaleSetSku(sku *compute.Sku) []interface{} {
 	result := make(map[string]interface{})
 	result["name"] = *sku.Name
@@ -1437,6 +1632,21 @@ func expandVirtualMachineScaleSetSku(d *schema.ResourceData) (*compute.Sku, erro
 	return sku, nil
 }
 
+func expandAzureRmVirtualMachineScaleSetRollingUpgradePolicy(d *schema.ResourceData) *compute.RollingUpgradePolicy {
+	policies := d.Get("rolling_upgrade_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+
+	policy := policies[0].(map[string]interface{})
+	return &compute.RollingUpgradePolicy{
+		MaxBatchInstancePercent:             utils.Int32(int32(policy["max_batch_instance_percent"].(int))),
+		MaxUnhealthyInstancePercent:         utils.Int32(int32(policy["max_unhealthy_instance_percent"].(int))),
+		MaxUnhealthyUpgradedInstancePercent: utils.Int32(int32(policy["max_unhealthy_upgraded_instance_percent"].(int))),
+		PauseTimeBetweenBatches:             utils.String(policy["pause_time_between_batches"].(string)),
+	}
+}
+
 func expandAzureRmVirtualMachineScaleSetNetworkProfile(d *schema.ResourceData) *compute.VirtualMachineScaleSetNetworkProfile {
 	scaleSetNetworkProfileConfigs := d.Get("network_profile").(*schema.Set).List()
 	networkProfileConfig := make([]compute.VirtualMachineScaleSetNetworkConfiguration, 0, len(scaleSetNetworkProfileConfigs))
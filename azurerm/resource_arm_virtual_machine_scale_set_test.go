@@ -1,15 +1,18 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/fakearm"
 )
 
 func TestAccAzureRMVirtualMachineScaleSet_basic(t *testing.T) {
@@ -802,6 +805,184 @@ func TestAccAzureRMVirtualMachineScaleSet_importBasic_managedDisk_withZones(t *t
 	})
 }
 
+func TestAccAzureRMVirtualMachineScaleSet_rollingUpgrade(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSet_rollingUpgrade(ri, location, "16.04-LTS"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "upgrade_policy_mode", "Rolling"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_upgrade_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_upgrade_policy.0.max_batch_instance_percent", "21"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_upgrade_policy.0.pause_time_between_batches", "PT30S"),
+					resource.TestCheckResourceAttrSet(resourceName, "health_probe_id"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSet_rollingUpgrade(ri, location, "18.04-LTS"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "storage_profile_image_reference.#", "1"),
+					testCheckAzureRMVirtualMachineScaleSetRollingUpgradeCompleted(resourceName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"os_profile.0.admin_password"},
+			},
+		},
+	})
+}
+
+func TestUnitAzureRMVirtualMachineScaleSet_rollingUpgrade(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set.test"
+	ri := acctest.RandInt()
+	location := "westeurope"
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	// Azure starts a Rolling Upgrade when the image used by a Scale Set with the `Rolling` Upgrade Policy changes
+	var previousImage interface{}
+	server.Computed("Microsoft.Compute/virtualMachineScaleSets", func(scaleSet map[string]interface{}) {
+		profile := scaleSet["properties"].(map[string]interface{})["virtualMachineProfile"].(map[string]interface{})
+
+		// like the real API, the DNS Servers are always returned
+		networkConfigurations := profile["networkProfile"].(map[string]interface{})["networkInterfaceConfigurations"].([]interface{})
+		for _, v := range networkConfigurations {
+			dnsSettings := v.(map[string]interface{})["properties"].(map[string]interface{})["dnsSettings"].(map[string]interface{})
+			if dnsSettings["dnsServers"] == nil {
+				dnsSettings["dnsServers"] = []interface{}{}
+			}
+		}
+
+		image := profile["storageProfile"].(map[string]interface{})["imageReference"].(map[string]interface{})["sku"]
+		if previousImage != nil && previousImage != image {
+			// the Server is locked whilst computing the Scale Set, so the Rolling Upgrade is started once it's stored
+			go server.Put(fmt.Sprintf("%s/rollingUpgrades/latest", scaleSet["id"]), map[string]interface{}{
+				"properties": map[string]interface{}{
+					"runningStatus": map[string]interface{}{
+						"code":      "Completed",
+						"startTime": time.Now().UTC().Format(time.RFC3339),
+					},
+				},
+			})
+		}
+		previousImage = image
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testUnitProviders(server),
+		CheckDestroy: testCheckFakeResourcesDestroyed(server),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSet_rollingUpgradeFakeLoadBalancer(ri, location, "16.04-LTS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "upgrade_policy_mode", "Rolling"),
+					resource.TestCheckResourceAttr(resourceName, "automatic_os_upgrade", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_upgrade_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_upgrade_policy.0.max_batch_instance_percent", "21"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_upgrade_policy.0.max_unhealthy_instance_percent", "22"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_upgrade_policy.0.max_unhealthy_upgraded_instance_percent", "23"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_upgrade_policy.0.pause_time_between_batches", "PT30S"),
+					resource.TestCheckResourceAttr(resourceName, "health_probe_id", fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/loadBalancers/acctestlb-%d/probes/ssh", fakearm.SubscriptionID, ri, ri)),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSet_rollingUpgradeFakeLoadBalancer(ri, location, "18.04-LTS"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "storage_profile_image_reference.#", "1"),
+					func(s *terraform.State) error {
+						id := fmt.Sprintf("%s/rollingUpgrades/latest", s.RootModule().Resources[resourceName].Primary.ID)
+						if _, exists := server.Get(id); !exists {
+							return fmt.Errorf("Bad: expected a Rolling Upgrade to have been started for %q", resourceName)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestVirtualMachineScaleSetRollingUpgradeStateRefreshFunc(t *testing.T) {
+	server := fakearm.NewServer()
+	defer server.Close()
+
+	client := compute.NewVirtualMachineScaleSetRollingUpgradesClientWithBaseURI(server.URL, fakearm.SubscriptionID)
+	latestId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG/providers/Microsoft.Compute/virtualMachineScaleSets/acctvmss/rollingUpgrades/latest", fakearm.SubscriptionID)
+	previous := "2019-01-01T00:00:00Z"
+
+	cases := []struct {
+		Name        string
+		StartTime   string
+		Code        string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Name:     "Not Started",
+			Expected: "Pending",
+		},
+		{
+			Name:      "Previous Rolling Upgrade",
+			StartTime: previous,
+			Code:      "Completed",
+			Expected:  "Pending",
+		},
+		{
+			Name:      "Rolling Forward",
+			StartTime: "2019-01-02T00:00:00Z",
+			Code:      "RollingForward",
+			Expected:  "RollingForward",
+		},
+		{
+			Name:      "Completed",
+			StartTime: "2019-01-02T00:00:00Z",
+			Code:      "Completed",
+			Expected:  "Completed",
+		},
+		{
+			Name:        "Faulted",
+			StartTime:   "2019-01-02T00:00:00Z",
+			Code:        "Faulted",
+			Expected:    "Faulted",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		if tc.StartTime != "" {
+			server.Put(latestId, map[string]interface{}{
+				"properties": map[string]interface{}{
+					"runningStatus": map[string]interface{}{
+						"code":      tc.Code,
+						"startTime": tc.StartTime,
+					},
+				},
+			})
+		}
+
+		refresh := virtualMachineScaleSetRollingUpgradeStateRefreshFunc(context.Background(), client, "acctestRG", "acctvmss", &previous)
+		_, state, err := refresh()
+		if tc.ExpectError != (err != nil) {
+			t.Fatalf("Expected an error to be %t for %q but got: %+v", tc.ExpectError, tc.Name, err)
+		}
+		if state != tc.Expected {
+			t.Fatalf("Expected the state to be %q for %q but got %q", tc.Expected, tc.Name, state)
+		}
+	}
+}
+
 func testGetAzureRMVirtualMachineScaleSet(s *terraform.State, resourceName string) (result *compute.VirtualMachineScaleSet, err error) {
 	// Ensure we have enough information in state to look up in API
 	rs, ok := s.RootModule().Resources[resourceName]
@@ -870,6 +1051,35 @@ func testCheckAzureRMVirtualMachineScaleSetDisappears(name string) resource.Test
 	}
 }
 
+func testCheckAzureRMVirtualMachineScaleSetRollingUpgradeCompleted(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		scaleSetName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).rollingUpgradesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetLatest(ctx, resourceGroup, scaleSetName)
+		if err != nil {
+			return fmt.Errorf("Bad: GetLatest on rollingUpgradesClient: %+v", err)
+		}
+
+		if props := resp.RollingUpgradeStatusInfoProperties; props != nil && props.RunningStatus != nil {
+			if code := props.RunningStatus.Code; code != compute.RollingUpgradeStatusCodeCompleted {
+				return fmt.Errorf("Bad: expected the latest Rolling Upgrade to be Completed but got %q", code)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("Bad: the latest Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q) had no status", scaleSetName, resourceGroup)
+	}
+}
+
 func testCheckAzureRMVirtualMachineScaleSetDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).vmScaleSetClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -4180,3 +4390,152 @@ resource "azurerm_virtual_machine_scale_set" "test" {
 }
 `, rInt, location)
 }
+
+func testAccAzureRMVirtualMachineScaleSet_rollingUpgrade(rInt int, location string, imageSku string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "acctestlb-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  frontend_ip_configuration {
+    name                          = "internal"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "test" {
+  name                = "test"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  loadbalancer_id     = "${azurerm_lb.test.id}"
+}
+
+resource "azurerm_lb_probe" "test" {
+  name                = "ssh"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  loadbalancer_id     = "${azurerm_lb.test.id}"
+  port                = 22
+}
+
+resource "azurerm_lb_rule" "test" {
+  name                           = "ssh"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  loadbalancer_id                = "${azurerm_lb.test.id}"
+  protocol                       = "Tcp"
+  frontend_port                  = 22
+  backend_port                   = 22
+  frontend_ip_configuration_name = "internal"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.test.id}"
+  probe_id                       = "${azurerm_lb_probe.test.id}"
+}
+
+%[3]s
+`, rInt, location, testAccAzureRMVirtualMachineScaleSet_rollingUpgradeScaleSet(rInt, imageSku, "${azurerm_subnet.test.id}", "${azurerm_lb_backend_address_pool.test.id}", "${azurerm_lb_probe.test.id}", `
+  # the Health Probe must be used by a Load Balancing Rule before it can be assigned to the Scale Set
+  depends_on = ["azurerm_lb_rule.test"]
+`))
+}
+
+func testAccAzureRMVirtualMachineScaleSet_rollingUpgradeFakeLoadBalancer(rInt int, location string, imageSku string) string {
+	loadBalancerId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/loadBalancers/acctestlb-%d", fakearm.SubscriptionID, rInt, rInt)
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+%[3]s
+`, rInt, location, testAccAzureRMVirtualMachineScaleSet_rollingUpgradeScaleSet(rInt, imageSku, "${azurerm_subnet.test.id}", loadBalancerId+"/backendAddressPools/test", loadBalancerId+"/probes/ssh", ""))
+}
+
+func testAccAzureRMVirtualMachineScaleSet_rollingUpgradeScaleSet(rInt int, imageSku string, subnetId string, backendAddressPoolId string, healthProbeId string, dependsOn string) string {
+	return fmt.Sprintf(`
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name                 = "acctvmss-%[1]d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  upgrade_policy_mode  = "Rolling"
+  health_probe_id      = "%[5]s"
+  automatic_os_upgrade = true
+%[6]s
+  rolling_upgrade_policy {
+    max_batch_instance_percent              = 21
+    max_unhealthy_instance_percent          = 22
+    max_unhealthy_upgraded_instance_percent = 23
+    pause_time_between_batches              = "PT30S"
+  }
+
+  sku {
+    name     = "Standard_F2"
+    tier     = "Standard"
+    capacity = 2
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%[1]d"
+    admin_username       = "myadmin"
+    admin_password       = "Passwword1234"
+  }
+
+  network_profile {
+    name    = "TestNetworkProfile-%[1]d"
+    primary = true
+
+    ip_configuration {
+      name                                   = "TestIPConfiguration"
+      primary                                = true
+      subnet_id                              = "%[3]s"
+      load_balancer_backend_address_pool_ids = ["%[4]s"]
+    }
+  }
+
+  storage_profile_os_disk {
+    name              = ""
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "%[2]s"
+    version   = "latest"
+  }
+}
+`, rInt, imageSku, subnetId, backendAddressPoolId, healthProbeId, dependsOn)
+}
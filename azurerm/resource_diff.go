@@ -0,0 +1,20 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceDiffMapKnown returns whether all of the values in the map `key` are known during the plan. Maps
+// containing a value interpolated from a resource which hasn't been created yet are marked as computed as a
+// whole, which the version of Terraform in use doesn't handle when reading them from a ResourceDiff (it panics
+// rather than returning `config.UnknownVariableValue`) - so this has to be checked before calling `d.Get(key)`
+func resourceDiffMapKnown(d *schema.ResourceDiff, key string) (known bool) {
+	defer func() {
+		if recover() != nil {
+			known = false
+		}
+	}()
+
+	d.Get(key)
+	return true
+}
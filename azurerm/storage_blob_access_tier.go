@@ -0,0 +1,153 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// Blob Access Tiers were introduced in version 2017-04-17 of the Storage API, which is newer than the version used
+// by the Storage SDK - as such the Access Tier of a Blob is retrieved/set using requests authenticated with an Account SAS
+const storageBlobAccessTierAPIVersion = "2017-04-17"
+
+type storageBlobAccessTierClient struct {
+	autorest.Client
+	sasToken url.Values
+}
+
+func (armClient *ArmClient) getStorageBlobAccessTierClient(ctx context.Context, resourceGroupName, storageAccountName string) (*storageBlobAccessTierClient, bool, error) {
+	key, accountExists, err := armClient.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil {
+		return nil, accountExists, err
+	}
+	if !accountExists {
+		return nil, false, nil
+	}
+
+	storageClient, err := mainStorage.NewClient(storageAccountName, key, armClient.environment.StorageEndpointSuffix,
+		mainStorage.DefaultAPIVersion, true)
+	if err != nil {
+		return nil, true, fmt.Errorf("Error creating storage client for storage account %q: %s", storageAccountName, err)
+	}
+
+	token, err := storageClient.GetAccountSASToken(mainStorage.AccountSASTokenOptions{
+		APIVersion: storageBlobAccessTierAPIVersion,
+		Services: mainStorage.Services{
+			Blob: true,
+		},
+		ResourceTypes: mainStorage.ResourceTypes{
+			Object: true,
+		},
+		Permissions: mainStorage.Permissions{
+			Read:  true,
+			Write: true,
+		},
+		Expiry:   time.Now().UTC().Add(1 * time.Hour),
+		UseHTTPS: true,
+	})
+	if err != nil {
+		return nil, true, fmt.Errorf("Error creating SAS Token for storage account %q: %s", storageAccountName, err)
+	}
+
+	client := armClient.newStorageBlobAccessTierClient(token)
+	return &client, true, nil
+}
+
+func (armClient *ArmClient) newStorageBlobAccessTierClient(sasToken url.Values) storageBlobAccessTierClient {
+	client := storageBlobAccessTierClient{
+		Client:   autorest.NewClientWithUserAgent(""),
+		sasToken: sasToken,
+	}
+	// these requests are authenticated using the SAS Token (which is redacted from the logs) rather than a Bearer Token
+	armClient.configureClient(&client.Client, autorest.NullAuthorizer{})
+	return client
+}
+
+// Get returns the Access Tier of the Blob - which is empty when the Storage Account doesn't support Access Tiers
+func (c storageBlobAccessTierClient) Get(ctx context.Context, blobURL string) (string, error) {
+	req, err := c.newRequest(ctx, blobURL, url.Values{}, autorest.AsHead())
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving the Access Tier for Blob %q: %+v", blobURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Error retrieving the Access Tier for Blob %q: unexpected status %d", blobURL, resp.StatusCode)
+	}
+
+	return resp.Header.Get("x-ms-access-tier"), nil
+}
+
+// Set updates the Access Tier of the Blob, e.g. to `Cool`
+func (c storageBlobAccessTierClient) Set(ctx context.Context, blobURL string, accessTier string) error {
+	req, err := c.newRequest(ctx, blobURL, url.Values{"comp": {"tier"}},
+		autorest.AsPut(),
+		autorest.WithHeader("x-ms-access-tier", accessTier))
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.send(req)
+	if err != nil {
+		return fmt.Errorf("Error setting the Access Tier for Blob %q to %q: %+v", blobURL, accessTier, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("Error setting the Access Tier for Blob %q to %q: unexpected status %d", blobURL, accessTier, resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c storageBlobAccessTierClient) newRequest(ctx context.Context, blobURL string, params url.Values, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	uri, err := url.Parse(blobURL)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a URI: %+v", blobURL, err)
+	}
+
+	query := uri.Query()
+	for k, v := range c.sasToken {
+		query[k] = v
+	}
+	for k, v := range params {
+		query[k] = v
+	}
+	uri.RawQuery = query.Encode()
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(uri.String()),
+		autorest.WithHeader("x-ms-version", storageBlobAccessTierAPIVersion))
+	req, err := autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("Error building request for Blob %q: %+v", blobURL, err)
+	}
+
+	return req, nil
+}
+
+func (c storageBlobAccessTierClient) send(req *http.Request) (*http.Response, error) {
+	resp, err := autorest.SendWithSender(c, req)
+	if err != nil {
+		// the URI (which contains the SAS Token) is omitted from the error
+		if permanentErr, ok := err.(permanentRequestError); ok {
+			err = permanentErr.err
+		}
+		if urlErr, ok := err.(*url.Error); ok {
+			return nil, urlErr.Err
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
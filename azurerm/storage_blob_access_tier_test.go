@@ -0,0 +1,79 @@
+package azurerm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStorageBlobAccessTierClient(t *testing.T) {
+	accessTier := "Hot"
+	throttled := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the first request is throttled, which should be retried
+		if !throttled {
+			throttled = true
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		if !strings.Contains(r.UserAgent(), "HashiCorp-Terraform-v") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.URL.Path != "/container/example.txt" || r.URL.Query().Get("sig") != "signature" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.Header.Get("x-ms-version") != storageBlobAccessTierAPIVersion {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodHead:
+			w.Header().Set("x-ms-access-tier", accessTier)
+			w.WriteHeader(http.StatusOK)
+		case r.Method == http.MethodPut && r.URL.Query().Get("comp") == "tier":
+			accessTier = r.Header.Get("x-ms-access-tier")
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+
+	armClient := ArmClient{
+		maxRetries:   1,
+		maxRetryWait: 10 * time.Millisecond,
+	}
+	client := armClient.newStorageBlobAccessTierClient(url.Values{"sig": {"signature"}})
+	ctx := context.Background()
+	blobUrl := server.URL + "/container/example.txt"
+
+	actual, err := client.Get(ctx, blobUrl)
+	if err != nil {
+		t.Fatalf("Error retrieving the Access Tier: %+v", err)
+	}
+	if actual != "Hot" {
+		t.Fatalf("Expected the Access Tier to be %q but got %q", "Hot", actual)
+	}
+
+	if err := client.Set(ctx, blobUrl, "Cool"); err != nil {
+		t.Fatalf("Error setting the Access Tier: %+v", err)
+	}
+	if accessTier != "Cool" {
+		t.Fatalf("Expected the Access Tier to be updated to %q but got %q", "Cool", accessTier)
+	}
+
+	_, err = client.Get(ctx, server.URL+"/container/missing.txt")
+	if err == nil {
+		t.Fatalf("Expected an error when the request isn't authorized")
+	}
+	if strings.Contains(err.Error(), "signature") {
+		t.Fatalf("Expected the SAS Token to be omitted from the error but got %q", err.Error())
+	}
+}
@@ -0,0 +1,142 @@
+package azurerm
+
+import (
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+// subscriptionIdOverrideSchema returns the Schema for the optional `subscription_id` argument, which allows
+// a resource (or data source) to be managed in a different Subscription to the one configured in the provider
+func subscriptionIdOverrideSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     forceNew,
+		ValidateFunc: validate.UUID,
+	}
+}
+
+// addSubscriptionIdToResource adds the optional `subscription_id` argument to a resource (or data source) which
+// doesn't already define one, and wraps its functions such that they're called with a client for that Subscription
+func addSubscriptionIdToResource(r *schema.Resource, isDataSource bool) {
+	if _, ok := r.Schema["subscription_id"]; ok {
+		return
+	}
+
+	r.Schema["subscription_id"] = subscriptionIdOverrideSchema(!isDataSource)
+
+	if r.Create != nil {
+		create := r.Create
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			client := subscriptionClient(d, meta)
+			if err := create(d, client); err != nil {
+				return err
+			}
+
+			setSubscriptionId(d, client)
+			return nil
+		}
+	}
+
+	if r.Read != nil {
+		read := r.Read
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			client := subscriptionClient(d, meta)
+			if err := read(d, client); err != nil {
+				return err
+			}
+
+			setSubscriptionId(d, client)
+			return nil
+		}
+	}
+
+	if r.Update != nil {
+		update := r.Update
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			client := subscriptionClient(d, meta)
+			if err := update(d, client); err != nil {
+				return err
+			}
+
+			setSubscriptionId(d, client)
+			return nil
+		}
+	}
+
+	if r.Delete != nil {
+		destroy := r.Delete
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			return destroy(d, subscriptionClient(d, meta))
+		}
+	}
+
+	if r.Exists != nil {
+		exists := r.Exists
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			return exists(d, subscriptionClient(d, meta))
+		}
+	}
+
+	if r.CustomizeDiff != nil {
+		customizeDiff := r.CustomizeDiff
+		r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+			return customizeDiff(d, subscriptionClient(d, meta))
+		}
+	}
+
+	if r.Importer != nil && r.Importer.State != nil {
+		importer := r.Importer.State
+		r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			setSubscriptionIdFromResourceId(d, meta)
+			return importer(d, subscriptionClient(d, meta))
+		}
+	}
+}
+
+// resourceGetter is implemented by both schema.ResourceData and schema.ResourceDiff
+type resourceGetter interface {
+	Get(key string) interface{}
+}
+
+// subscriptionClient returns the client for the Subscription specified in the `subscription_id` argument,
+// falling back to the client for the Subscription configured in the provider
+func subscriptionClient(d resourceGetter, meta interface{}) interface{} {
+	client, ok := meta.(*ArmClient)
+	if !ok {
+		return meta
+	}
+
+	return client.forSubscription(d.Get("subscription_id").(string))
+}
+
+// setSubscriptionId sets the `subscription_id` attribute to the Subscription the resource was managed in
+func setSubscriptionId(d *schema.ResourceData, client interface{}) {
+	if c, ok := client.(*ArmClient); ok && d.Id() != "" {
+		d.Set("subscription_id", c.subscriptionId)
+	}
+}
+
+// setSubscriptionIdFromResourceId sets the `subscription_id` argument when importing a resource from a
+// different Subscription to the one configured in the provider, such that it's read using the correct client
+func setSubscriptionIdFromResourceId(d *schema.ResourceData, meta interface{}) {
+	client, ok := meta.(*ArmClient)
+	if !ok {
+		return
+	}
+
+	// not every resource uses a Resource Manager ID (e.g. Key Vault Secrets) - which are imported as-is
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil || id.SubscriptionID == "" {
+		return
+	}
+
+	if !strings.EqualFold(id.SubscriptionID, client.subscriptionId) {
+		log.Printf("[DEBUG] Importing %q from Subscription %q", d.Id(), id.SubscriptionID)
+		d.Set("subscription_id", id.SubscriptionID)
+	}
+}
@@ -0,0 +1,49 @@
+package azurerm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestArmClientForSubscription(t *testing.T) {
+	client := &ArmClient{
+		subscriptionId: "00000000-0000-0000-0000-000000000000",
+		environment:    azure.PublicCloud,
+		defaultTags:    map[string]string{"environment": "Production"},
+		StopContext:    context.Background(),
+	}
+	otherSubscriptionId := "aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"
+
+	if actual := client.forSubscription(""); actual != client {
+		t.Fatalf("Expected the provider's client to be returned when no Subscription is specified")
+	}
+	if actual := client.forSubscription("00000000-0000-0000-0000-000000000000"); actual != client {
+		t.Fatalf("Expected the provider's client to be returned for the provider's Subscription")
+	}
+
+	other := client.forSubscription(otherSubscriptionId)
+	if other == client {
+		t.Fatalf("Expected a new client to be returned for another Subscription")
+	}
+	if other.subscriptionId != otherSubscriptionId {
+		t.Fatalf("Expected the Subscription ID to be %q but got %q", otherSubscriptionId, other.subscriptionId)
+	}
+	if other.resourceGroupsClient.SubscriptionID != otherSubscriptionId {
+		t.Fatalf("Expected the clients to be configured for Subscription %q but got %q", otherSubscriptionId, other.resourceGroupsClient.SubscriptionID)
+	}
+	if other.defaultTags["environment"] != "Production" {
+		t.Fatalf("Expected the provider configuration to be copied to the new client")
+	}
+
+	// Subscription ID's are case-insensitive
+	if actual := client.forSubscription("AAAAAAAA-AAAA-AAAA-AAAA-AAAAAAAAAAAA"); actual != other {
+		t.Fatalf("Expected the client for %q to be cached", otherSubscriptionId)
+	}
+
+	client.resetSubscriptionClients()
+	if actual := client.forSubscription(otherSubscriptionId); actual == other {
+		t.Fatalf("Expected the client for %q to be rebuilt after being reset", otherSubscriptionId)
+	}
+}
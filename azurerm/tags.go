@@ -3,6 +3,7 @@ package azurerm
 import (
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -27,6 +28,15 @@ func tagsForceNewSchema() *schema.Schema {
 	}
 }
 
+// tagsAllSchema returns the Schema for the `tags_all` attribute, which contains the effective set of tags
+// for a resource - that is, the provider's `default_tags` merged with the resource's `tags`
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+}
+
 func tagsForDataSourceSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeMap,
@@ -115,3 +125,187 @@ func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
 
 	d.Set("tags", output)
 }
+
+// flattenAndSetResourceTags sets both the `tags` and `tags_all` attributes for a resource from the tags returned
+// from the API - where any tags inherited from the provider's `default_tags` are omitted from `tags`, such that
+// they don't show as a diff. Tags matching the provider's `ignore_tags` are omitted from both, unless they've
+// been explicitly specified on the resource.
+func flattenAndSetResourceTags(d *schema.ResourceData, meta interface{}, tagMap map[string]*string) {
+	defaultTags := providerDefaultTags(meta)
+	ignoreTags := providerIgnoreTags(meta)
+	configuredTags := d.Get("tags").(map[string]interface{})
+
+	tags := make(map[string]interface{}, len(tagMap))
+	tagsAll := make(map[string]interface{}, len(tagMap))
+
+	for k, v := range tagMap {
+		_, isConfigured := configuredTags[k]
+		if !isConfigured && ignoreTags.ignored(k) {
+			continue
+		}
+
+		value := ""
+		if v != nil {
+			value = *v
+		}
+		tagsAll[k] = value
+
+		// unless it's also been specified on the resource
+		if defaultValue, isDefault := defaultTags[k]; isDefault && defaultValue == value && !isConfigured {
+			continue
+		}
+		tags[k] = value
+	}
+
+	d.Set("tags", tags)
+	d.Set("tags_all", tagsAll)
+}
+
+// mergeDefaultTags returns the provider's `default_tags` merged with the specified tags for a resource, where
+// the tags specified on the resource take precedence
+func mergeDefaultTags(meta interface{}, tagsMap map[string]interface{}) map[string]interface{} {
+	defaultTags := providerDefaultTags(meta)
+
+	output := make(map[string]interface{}, len(defaultTags)+len(tagsMap))
+	for k, v := range defaultTags {
+		output[k] = v
+	}
+	for k, v := range tagsMap {
+		// Validate should have ignored this error already
+		value, _ := tagValueToString(v)
+		output[k] = value
+	}
+
+	return output
+}
+
+func providerDefaultTags(meta interface{}) map[string]string {
+	if client, ok := meta.(*ArmClient); ok && client.defaultTags != nil {
+		return client.defaultTags
+	}
+
+	return map[string]string{}
+}
+
+// ignoreTagsConfig contains the tags which are managed outside of Terraform (e.g. by Azure Policy), and as such
+// should be ignored when reading tags from the API
+type ignoreTagsConfig struct {
+	keys        []string
+	keyPrefixes []string
+}
+
+// ignored returns whether the tag with the specified key should be ignored - tag keys are case-insensitive in Azure
+func (c ignoreTagsConfig) ignored(key string) bool {
+	for _, v := range c.keys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range c.keyPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func providerIgnoreTags(meta interface{}) ignoreTagsConfig {
+	if client, ok := meta.(*ArmClient); ok {
+		return client.ignoreTags
+	}
+
+	return ignoreTagsConfig{}
+}
+
+func expandProviderIgnoreTags(d *schema.ResourceData) ignoreTagsConfig {
+	output := ignoreTagsConfig{
+		keys:        make([]string, 0),
+		keyPrefixes: make([]string, 0),
+	}
+
+	blocks := d.Get("ignore_tags").([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return output
+	}
+
+	block := blocks[0].(map[string]interface{})
+	for _, v := range block["keys"].(*schema.Set).List() {
+		output.keys = append(output.keys, v.(string))
+	}
+	for _, v := range block["key_prefixes"].(*schema.Set).List() {
+		output.keyPrefixes = append(output.keyPrefixes, v.(string))
+	}
+
+	return output
+}
+
+func expandProviderDefaultTags(d *schema.ResourceData) map[string]string {
+	output := make(map[string]string)
+
+	blocks := d.Get("default_tags").([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return output
+	}
+
+	block := blocks[0].(map[string]interface{})
+	for k, v := range block["tags"].(map[string]interface{}) {
+		// Validate should have ignored this error already
+		value, _ := tagValueToString(v)
+		output[k] = value
+	}
+
+	return output
+}
+
+// addTagsAllToResource adds the computed `tags_all` attribute to a resource which supports tags, along with
+// a CustomizeDiff which populates it - such that changes to the provider's `default_tags` are shown in the plan
+func addTagsAllToResource(r *schema.Resource) {
+	tags, ok := r.Schema["tags"]
+	if !ok || tags.Type != schema.TypeMap || !tags.Optional {
+		return
+	}
+
+	tagsAll := tagsAllSchema()
+	tagsAll.ForceNew = tags.ForceNew
+	r.Schema["tags_all"] = tagsAll
+
+	if existing := r.CustomizeDiff; existing != nil {
+		r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+			if err := existing(d, meta); err != nil {
+				return err
+			}
+
+			return setTagsAllDiff(d, meta)
+		}
+	} else {
+		r.CustomizeDiff = setTagsAllDiff
+	}
+}
+
+func setTagsAllDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// when the tags for the resource aren't known until apply-time (e.g. they're interpolated from another
+	// resource) the effective set of tags isn't known either
+	if !resourceDiffMapKnown(d, "tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+	merged := mergeDefaultTags(meta, tags)
+
+	// ignored tags aren't set in `tags_all` when reading the resource - so they shouldn't be planned either
+	ignoreTags := providerIgnoreTags(meta)
+	for k := range merged {
+		if _, isConfigured := tags[k]; !isConfigured && ignoreTags.ignored(k) {
+			delete(merged, k)
+		}
+	}
+
+	existing, _ := d.GetChange("tags_all")
+	if reflect.DeepEqual(existing, merged) {
+		return nil
+	}
+
+	return d.SetNew("tags_all", merged)
+}
@@ -2,8 +2,11 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
@@ -113,3 +116,172 @@ func TestFilterARMTags(t *testing.T) {
 		t.Fatalf("Expected %v in filtered tag map, got %v", valueData[1], *filtered["key2"])
 	}
 }
+
+func TestMergeDefaultTags(t *testing.T) {
+	cases := []struct {
+		Name        string
+		DefaultTags map[string]string
+		Tags        map[string]interface{}
+		Expected    map[string]interface{}
+	}{
+		{
+			Name:        "No Default Tags",
+			DefaultTags: map[string]string{},
+			Tags:        map[string]interface{}{"environment": "Production", "count": 3},
+			Expected:    map[string]interface{}{"environment": "Production", "count": "3"},
+		},
+		{
+			Name:        "Only Default Tags",
+			DefaultTags: map[string]string{"owner": "Infrastructure"},
+			Tags:        map[string]interface{}{},
+			Expected:    map[string]interface{}{"owner": "Infrastructure"},
+		},
+		{
+			Name:        "Resource Tags take Precedence",
+			DefaultTags: map[string]string{"owner": "Infrastructure", "environment": "Default"},
+			Tags:        map[string]interface{}{"environment": "Production"},
+			Expected:    map[string]interface{}{"owner": "Infrastructure", "environment": "Production"},
+		},
+	}
+
+	for _, v := range cases {
+		meta := &ArmClient{defaultTags: v.DefaultTags}
+		actual := mergeDefaultTags(meta, v.Tags)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected the merged tags for %q to be %+v but got %+v", v.Name, v.Expected, actual)
+		}
+	}
+}
+
+func TestFlattenAndSetResourceTags(t *testing.T) {
+	cases := []struct {
+		Name            string
+		DefaultTags     map[string]string
+		IgnoreTags      ignoreTagsConfig
+		ConfiguredTags  map[string]interface{}
+		APITags         map[string]string
+		ExpectedTags    map[string]interface{}
+		ExpectedTagsAll map[string]interface{}
+	}{
+		{
+			Name:            "No Default Tags",
+			DefaultTags:     map[string]string{},
+			ConfiguredTags:  map[string]interface{}{"environment": "Production"},
+			APITags:         map[string]string{"environment": "Production"},
+			ExpectedTags:    map[string]interface{}{"environment": "Production"},
+			ExpectedTagsAll: map[string]interface{}{"environment": "Production"},
+		},
+		{
+			Name:            "Default Tags are Omitted",
+			DefaultTags:     map[string]string{"owner": "Infrastructure"},
+			ConfiguredTags:  map[string]interface{}{"environment": "Production"},
+			APITags:         map[string]string{"environment": "Production", "owner": "Infrastructure"},
+			ExpectedTags:    map[string]interface{}{"environment": "Production"},
+			ExpectedTagsAll: map[string]interface{}{"environment": "Production", "owner": "Infrastructure"},
+		},
+		{
+			Name:            "Default Tag also Configured",
+			DefaultTags:     map[string]string{"owner": "Infrastructure"},
+			ConfiguredTags:  map[string]interface{}{"owner": "Infrastructure"},
+			APITags:         map[string]string{"owner": "Infrastructure"},
+			ExpectedTags:    map[string]interface{}{"owner": "Infrastructure"},
+			ExpectedTagsAll: map[string]interface{}{"owner": "Infrastructure"},
+		},
+		{
+			Name:            "Default Tag Changed Outside of Terraform",
+			DefaultTags:     map[string]string{"owner": "Infrastructure"},
+			ConfiguredTags:  map[string]interface{}{},
+			APITags:         map[string]string{"owner": "Networking"},
+			ExpectedTags:    map[string]interface{}{"owner": "Networking"},
+			ExpectedTagsAll: map[string]interface{}{"owner": "Networking"},
+		},
+		{
+			Name:            "Ignored Tags are Omitted",
+			DefaultTags:     map[string]string{},
+			IgnoreTags:      ignoreTagsConfig{keys: []string{"CreatedBy"}, keyPrefixes: []string{"hidden-link:"}},
+			ConfiguredTags:  map[string]interface{}{"environment": "Production"},
+			APITags:         map[string]string{"environment": "Production", "CreatedBy": "policy", "hidden-link:/app-insights": "Resource"},
+			ExpectedTags:    map[string]interface{}{"environment": "Production"},
+			ExpectedTagsAll: map[string]interface{}{"environment": "Production"},
+		},
+		{
+			Name:            "Ignored Tag also Configured",
+			DefaultTags:     map[string]string{},
+			IgnoreTags:      ignoreTagsConfig{keys: []string{"CreatedBy"}},
+			ConfiguredTags:  map[string]interface{}{"CreatedBy": "terraform"},
+			APITags:         map[string]string{"CreatedBy": "terraform"},
+			ExpectedTags:    map[string]interface{}{"CreatedBy": "terraform"},
+			ExpectedTagsAll: map[string]interface{}{"CreatedBy": "terraform"},
+		},
+	}
+
+	for _, v := range cases {
+		r := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags":     tagsSchema(),
+				"tags_all": tagsAllSchema(),
+			},
+		}
+		d := r.TestResourceData()
+		d.Set("tags", v.ConfiguredTags)
+
+		apiTags := make(map[string]*string)
+		for k := range v.APITags {
+			value := v.APITags[k]
+			apiTags[k] = &value
+		}
+
+		meta := &ArmClient{defaultTags: v.DefaultTags, ignoreTags: v.IgnoreTags}
+		flattenAndSetResourceTags(d, meta, apiTags)
+
+		if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, v.ExpectedTags) {
+			t.Fatalf("Expected `tags` for %q to be %+v but got %+v", v.Name, v.ExpectedTags, actual)
+		}
+		if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, v.ExpectedTagsAll) {
+			t.Fatalf("Expected `tags_all` for %q to be %+v but got %+v", v.Name, v.ExpectedTagsAll, actual)
+		}
+	}
+}
+
+func TestIgnoreTagsConfig(t *testing.T) {
+	config := ignoreTagsConfig{
+		keys:        []string{"CreatedBy"},
+		keyPrefixes: []string{"hidden-link:"},
+	}
+
+	cases := []struct {
+		Key      string
+		Expected bool
+	}{
+		{
+			Key:      "CreatedBy",
+			Expected: true,
+		},
+		{
+			Key:      "createdby",
+			Expected: true,
+		},
+		{
+			Key:      "CreatedByUser",
+			Expected: false,
+		},
+		{
+			Key:      "hidden-link:/app-insights-resource-id",
+			Expected: true,
+		},
+		{
+			Key:      "Hidden-Link:/app-insights-resource-id",
+			Expected: true,
+		},
+		{
+			Key:      "environment",
+			Expected: false,
+		},
+	}
+
+	for _, v := range cases {
+		if actual := config.ignored(v.Key); actual != v.Expected {
+			t.Fatalf("Expected ignored for %q to be %t but got %t", v.Key, v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the functions in this file are shared between the Template Deployment resources for the Subscription and
+// Management Group scopes, which only differ in how the scope of the Deployment is specified
+
+type templateDeploymentAtScopeId struct {
+	Scope string
+	Name  string
+}
+
+func parseTemplateDeploymentAtScopeId(input string) (*templateDeploymentAtScopeId, error) {
+	// {scope}/providers/Microsoft.Resources/deployments/{name}
+	separator := "/providers/microsoft.resources/deployments/"
+	index := strings.LastIndex(strings.ToLower(input), separator)
+	if index <= 0 {
+		return nil, fmt.Errorf("Expected %q to be in the format `{scope}/providers/Microsoft.Resources/deployments/{name}`", input)
+	}
+
+	name := input[index+len(separator):]
+	if name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("Expected %q to be in the format `{scope}/providers/Microsoft.Resources/deployments/{name}`", input)
+	}
+
+	id := templateDeploymentAtScopeId{
+		Scope: input[:index],
+		Name:  name,
+	}
+	return &id, nil
+}
+
+func templateDeploymentAtScopeDeploy(d *schema.ResourceData, meta interface{}, scope string) error {
+	client := meta.(*ArmClient).deploymentsAtScopeClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	log.Printf("[INFO] preparing arguments for AzureRM Template Deployment %q (Scope %q).", name, scope)
+	// only Incremental deployments are supported at the Subscription and Management Group scopes
+	properties, err := expandArmTemplateDeploymentProperties(string(resources.Incremental), d.Get("template_body").(string), d.Get("parameters").(map[string]interface{}), d.Get("parameters_body").(string))
+	if err != nil {
+		return err
+	}
+
+	deployment := templateDeploymentAtScope{
+		Location:   utils.String(location),
+		Properties: properties,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, scope, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error deploying Template Deployment %q (Scope %q): %+v", name, scope, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deployment of Template Deployment %q (Scope %q): %+v", name, scope, err)
+	}
+
+	read, err := client.Get(ctx, scope, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Template Deployment %q (Scope %q): %+v", name, scope, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Template Deployment %q (Scope %q) ID", name, scope)
+	}
+
+	d.SetId(*read.ID)
+
+	return nil
+}
+
+// templateDeploymentAtScopeUpdateRequired returns whether the Template needs to be redeployed - since deployments
+// are idempotent the Template is redeployed in-place when the Template or Parameters change
+func templateDeploymentAtScopeUpdateRequired(d *schema.ResourceData) bool {
+	return d.HasChange("template_body") || d.HasChange("parameters") || d.HasChange("parameters_body")
+}
+
+// templateDeploymentAtScopeRead populates the common fields from the Deployment, returning the scope the Deployment
+// exists at - or an empty string when the Deployment no longer exists (in which case it's been removed from the state)
+func templateDeploymentAtScopeRead(d *schema.ResourceData, meta interface{}) (string, error) {
+	client := meta.(*ArmClient).deploymentsAtScopeClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseTemplateDeploymentAtScopeId(d.Id())
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.Get(ctx, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Template Deployment %q (Scope %q) was not found - removing from state!", id.Name, id.Scope)
+			d.SetId("")
+			return "", nil
+		}
+		return "", fmt.Errorf("Error making Read request on Template Deployment %q (Scope %q): %+v", id.Name, id.Scope, err)
+	}
+
+	d.Set("name", id.Name)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.Properties; props != nil {
+		outputs, outputsJson, err := flattenTemplateDeploymentOutputs(props.Outputs)
+		if err != nil {
+			return "", err
+		}
+		if err := d.Set("outputs", outputs); err != nil {
+			return "", fmt.Errorf("Error setting `outputs`: %+v", err)
+		}
+		d.Set("outputs_json", outputsJson)
+	}
+
+	return id.Scope, nil
+}
+
+func templateDeploymentAtScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).deploymentsAtScopeClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseTemplateDeploymentAtScopeId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, id.Scope, id.Name); err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Template Deployment %q (Scope %q): %+v", id.Name, id.Scope, err)
+	}
+
+	return waitForTemplateDeploymentAtScopeToBeDeleted(ctx, client, id.Scope, id.Name)
+}
+
+func waitForTemplateDeploymentAtScopeToBeDeleted(ctx context.Context, client templateDeploymentsAtScopeClient, scope, name string) error {
+	// we can't use the Waiter here since the API returns a 200 once it's deleted which is considered a polling status code..
+	log.Printf("[DEBUG] Waiting for Template Deployment (%q at Scope %q) to be deleted", name, scope)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"200"},
+		Target:  []string{"404"},
+		Refresh: templateDeploymentAtScopeStateStatusCodeRefreshFunc(ctx, client, scope, name),
+		Timeout: 40 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Template Deployment (%q at Scope %q) to be deleted: %+v", name, scope, err)
+	}
+
+	return nil
+}
+
+func templateDeploymentAtScopeStateStatusCodeRefreshFunc(ctx context.Context, client templateDeploymentsAtScopeClient, scope, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.Get(ctx, scope, name)
+
+		log.Printf("Retrieving Template Deployment %q (Scope %q) returned Status %d", name, scope, res.StatusCode)
+
+		if err != nil {
+			if utils.ResponseWasNotFound(res.Response) {
+				return res, strconv.Itoa(res.StatusCode), nil
+			}
+			return nil, "", fmt.Errorf("Error polling for the status of the Template Deployment %q (Scope %q): %+v", name, scope, err)
+		}
+
+		return res, strconv.Itoa(res.StatusCode), nil
+	}
+}
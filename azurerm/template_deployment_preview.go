@@ -0,0 +1,411 @@
+package azurerm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	templateDeploymentChangeCreate = "Create"
+	templateDeploymentChangeModify = "Modify"
+	templateDeploymentChangeDelete = "Delete"
+)
+
+// templateDeploymentExpression matches the Template Language Expressions which can be evaluated without deploying
+// the Template, e.g. `[parameters('storageAccountName')]` and `[variables('storageAccountName')]`
+var templateDeploymentExpression = regexp.MustCompile(`^\[\s*(parameters|variables)\(\s*'([^']+)'\s*\)\s*\]$`)
+
+// templateDeploymentPreviewDiff validates the Template and Parameters using the Deployments API, and sets the
+// `planned_changes` to the top-level resources which deploying the Template would create, modify or delete -
+// compared with the resources which were deployed by the current deployment
+func templateDeploymentPreviewDiff(d *schema.ResourceDiff, meta interface{}) error {
+	client := meta.(*ArmClient)
+	ctx := client.StopContext
+
+	name, nameOk := d.GetOk("name")
+	resourceGroup, resourceGroupOk := d.GetOk("resource_group_name")
+	templateBody, templateBodyOk := d.GetOk("template_body")
+	if !nameOk || !resourceGroupOk || !templateBodyOk {
+		log.Printf("[DEBUG] Unable to validate the Template Deployment since the `name`, `resource_group_name` or `template_body` aren't known yet")
+		return d.SetNewComputed("planned_changes")
+	}
+
+	template, err := expandTemplateBody(templateBody.(string))
+	if err != nil {
+		return err
+	}
+
+	// parameters which are interpolated from resources which haven't been created yet aren't known during the plan
+	if !resourceDiffMapKnown(d, "parameters") {
+		log.Printf("[DEBUG] Unable to validate Template Deployment %q since the `parameters` aren't known yet", name)
+		return d.SetNewComputed("planned_changes")
+	}
+
+	// an unknown `parameters_body` is read as an empty string, so the Parameters it specifies are reported as
+	// missing below rather than being sent to the API
+	parameters := d.Get("parameters").(map[string]interface{})
+	parametersBody := d.Get("parameters_body").(string)
+
+	values, err := templateDeploymentParameterValues(parameters, parametersBody)
+	if err != nil {
+		return err
+	}
+
+	if missing := templateDeploymentMissingParameters(template, values); len(missing) > 0 {
+		log.Printf("[DEBUG] Unable to validate Template Deployment %q since the Parameters %s aren't known yet", name, strings.Join(missing, ", "))
+		return d.SetNewComputed("planned_changes")
+	}
+
+	deploymentMode := d.Get("deployment_mode").(string)
+	properties, err := expandArmTemplateDeploymentProperties(deploymentMode, templateBody.(string), parameters, parametersBody)
+	if err != nil {
+		return err
+	}
+
+	deployment := resources.Deployment{
+		Properties: properties,
+	}
+	result, err := client.deploymentsClient.Validate(ctx, resourceGroup.(string), name.(string), deployment)
+	if err != nil {
+		if utils.ResponseWasNotFound(result.Response) {
+			log.Printf("[DEBUG] Unable to validate Template Deployment %q since Resource Group %q doesn't exist yet", name, resourceGroup)
+			return d.SetNewComputed("planned_changes")
+		}
+
+		return fmt.Errorf("Error validating Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if result.Error != nil {
+		return fmt.Errorf("Error validating Template Deployment %q (Resource Group %q): %s", name, resourceGroup, flattenTemplateDeploymentValidationError(result.Error))
+	}
+
+	resourceGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", client.subscriptionId, resourceGroup)
+	planned, unresolvedTypes := templateDeploymentTemplateResourceIds(resourceGroupId, template, values)
+	if props := result.Properties; props != nil && props.Dependencies != nil {
+		// the names of the resources in the dependencies are evaluated by the API
+		for _, dependency := range *props.Dependencies {
+			if dependency.ID != nil {
+				planned = append(planned, *dependency.ID)
+			}
+			if dependency.DependsOn != nil {
+				for _, dependsOn := range *dependency.DependsOn {
+					if dependsOn.ID != nil {
+						planned = append(planned, *dependsOn.ID)
+					}
+				}
+			}
+		}
+	}
+
+	current := make([]string, 0)
+	if d.Id() != "" && !d.HasChange("name") && !d.HasChange("resource_group_name") {
+		current, err = templateDeploymentDeployedResourceIds(ctx, client, resourceGroup.(string), name.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	complete := strings.EqualFold(deploymentMode, string(resources.Complete))
+	changes := templateDeploymentPlannedChanges(planned, current, unresolvedTypes, complete)
+	return d.SetNew("planned_changes", changes)
+}
+
+// templateDeploymentDeployedResourceIds returns the ID's of the top-level resources which were successfully
+// deployed by the Template Deployment, based on its Deployment Operations
+func templateDeploymentDeployedResourceIds(ctx context.Context, client *ArmClient, resourceGroup, name string) ([]string, error) {
+	ids := make([]string, 0)
+
+	operations, err := client.deploymentOperationsClient.ListComplete(ctx, resourceGroup, name, nil)
+	if err != nil {
+		if utils.ResponseWasNotFound(operations.Response().Response) {
+			return ids, nil
+		}
+		return nil, fmt.Errorf("Error listing Operations for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for operations.NotDone() {
+		if props := operations.Value().Properties; props != nil && props.TargetResource != nil && props.TargetResource.ID != nil {
+			succeeded := props.ProvisioningState != nil && strings.EqualFold(*props.ProvisioningState, "Succeeded")
+			if succeeded && isTemplateDeploymentTrackedResource(*props.TargetResource.ID) {
+				ids = append(ids, *props.TargetResource.ID)
+			}
+		}
+
+		if err := operations.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing Operations for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return ids, nil
+}
+
+// isTemplateDeploymentTrackedResource returns whether the resource is a top-level resource - child resources
+// (e.g. Subnets) aren't returned when listing the resources in a Resource Group, and nested deployments are
+// checked as part of the parent deployment
+func isTemplateDeploymentTrackedResource(input string) bool {
+	id, err := parseAzureResourceID(input)
+	if err != nil {
+		return false
+	}
+
+	return len(id.Path) == 1 && id.Provider != "" && !strings.EqualFold(id.Provider, "Microsoft.Resources")
+}
+
+// templateDeploymentParameterValues returns the values of the Parameters specified in either the `parameters`
+// or `parameters_body`, keyed by the (lower-cased) name of the Parameter
+func templateDeploymentParameterValues(parameters map[string]interface{}, parametersBody string) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+
+	if parametersBody != "" {
+		body, err := expandParametersBody(parametersBody)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range body {
+			// Key Vault references don't have a `value`, but are still specified
+			value := interface{}(nil)
+			if parameter, ok := v.(map[string]interface{}); ok {
+				value = parameter["value"]
+			}
+			values[strings.ToLower(k)] = value
+		}
+	}
+
+	for k, v := range parameters {
+		values[strings.ToLower(k)] = v
+	}
+
+	return values, nil
+}
+
+// templateDeploymentMissingParameters returns the (sorted) names of the Parameters defined in the Template which
+// don't have a default value and haven't been specified
+func templateDeploymentMissingParameters(template map[string]interface{}, values map[string]interface{}) []string {
+	missing := make([]string, 0)
+
+	parameters, ok := template["parameters"].(map[string]interface{})
+	if !ok {
+		return missing
+	}
+
+	for name, v := range parameters {
+		if parameter, ok := v.(map[string]interface{}); ok {
+			if _, hasDefault := parameter["defaultValue"]; hasDefault {
+				continue
+			}
+		}
+
+		if _, specified := values[strings.ToLower(name)]; !specified {
+			missing = append(missing, name)
+		}
+	}
+
+	sort.Strings(missing)
+	return missing
+}
+
+// templateDeploymentTemplateResourceIds returns the ID's of the top-level resources defined in the Template, together
+// with the Types of any resources whose names can't be evaluated without deploying the Template
+func templateDeploymentTemplateResourceIds(resourceGroupId string, template map[string]interface{}, values map[string]interface{}) ([]string, []string) {
+	ids := make([]string, 0)
+	unresolvedTypes := make([]string, 0)
+
+	templateResources, ok := template["resources"].([]interface{})
+	if !ok {
+		return ids, unresolvedTypes
+	}
+
+	for _, v := range templateResources {
+		resource, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		resourceType, _ := resource["type"].(string)
+		resourceName, _ := resource["name"].(string)
+		if resourceType == "" || strings.HasPrefix(resourceType, "[") {
+			continue
+		}
+
+		name, ok := evaluateTemplateDeploymentExpression(resourceName, template, values, true)
+		if !ok {
+			log.Printf("[DEBUG] Unable to evaluate the name %q of the %q defined in the Template", resourceName, resourceType)
+			unresolvedTypes = append(unresolvedTypes, resourceType)
+			continue
+		}
+
+		if id, ok := templateDeploymentResourceId(resourceGroupId, resourceType, name); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, unresolvedTypes
+}
+
+// evaluateTemplateDeploymentExpression evaluates the value of a literal, or a reference to a Parameter or Variable
+// (which can itself reference a Parameter) - returning false if the value can't be evaluated
+func evaluateTemplateDeploymentExpression(input string, template map[string]interface{}, values map[string]interface{}, allowVariables bool) (string, bool) {
+	if !strings.HasPrefix(input, "[") {
+		return input, true
+	}
+
+	// literal values starting with a `[` are escaped as `[[`
+	if strings.HasPrefix(input, "[[") {
+		return input[1:], true
+	}
+
+	match := templateDeploymentExpression.FindStringSubmatch(input)
+	if match == nil {
+		return "", false
+	}
+
+	switch match[1] {
+	case "parameters":
+		if v, ok := values[strings.ToLower(match[2])]; ok {
+			value, isString := v.(string)
+			return value, isString
+		}
+
+		// fall back to the default value of the Parameter, providing it's not an expression itself
+		parameters, _ := template["parameters"].(map[string]interface{})
+		for name, v := range parameters {
+			if !strings.EqualFold(name, match[2]) {
+				continue
+			}
+			if parameter, ok := v.(map[string]interface{}); ok {
+				if value, isString := parameter["defaultValue"].(string); isString && !strings.HasPrefix(value, "[") {
+					return value, true
+				}
+			}
+		}
+
+	case "variables":
+		if !allowVariables {
+			return "", false
+		}
+
+		variables, _ := template["variables"].(map[string]interface{})
+		for name, v := range variables {
+			if value, isString := v.(string); isString && strings.EqualFold(name, match[2]) {
+				return evaluateTemplateDeploymentExpression(value, template, values, false)
+			}
+		}
+	}
+
+	return "", false
+}
+
+// templateDeploymentResourceId returns the ID of a resource defined in a Template, where the name of a child
+// resource (e.g. `Microsoft.Network/virtualNetworks/subnets`) contains the name of its parent (e.g. `network/subnet`)
+func templateDeploymentResourceId(resourceGroupId, resourceType, name string) (string, bool) {
+	types := strings.Split(resourceType, "/")
+	names := strings.Split(name, "/")
+	if len(types) < 2 || len(types)-1 != len(names) {
+		return "", false
+	}
+
+	segments := []string{resourceGroupId, "providers", types[0]}
+	for i, v := range names {
+		segments = append(segments, types[i+1], v)
+	}
+
+	return strings.Join(segments, "/"), true
+}
+
+// templateDeploymentPlannedChanges compares the top-level resources defined in the Template with those deployed by
+// the current deployment. Resources which are no longer defined in the Template are only deleted in `Complete` mode,
+// unless the Template contains a resource of the same Type whose name can't be evaluated (which may be the same resource)
+func templateDeploymentPlannedChanges(planned []string, current []string, unresolvedTypes []string, complete bool) []interface{} {
+	currentIds := make(map[string]string)
+	for _, id := range current {
+		currentIds[strings.ToLower(id)] = id
+	}
+
+	actions := make(map[string]string)
+	ids := make(map[string]string)
+	for _, id := range planned {
+		if !isTemplateDeploymentTrackedResource(id) {
+			continue
+		}
+
+		key := strings.ToLower(id)
+		ids[key] = id
+		if _, exists := currentIds[key]; exists {
+			actions[key] = templateDeploymentChangeModify
+		} else {
+			actions[key] = templateDeploymentChangeCreate
+		}
+	}
+
+	if complete {
+		for key, id := range currentIds {
+			if _, exists := actions[key]; exists {
+				continue
+			}
+
+			resourceType := ""
+			if parsed, err := parseAzureResourceID(id); err == nil {
+				for k := range parsed.Path {
+					resourceType = fmt.Sprintf("%s/%s", parsed.Provider, k)
+				}
+			}
+
+			unresolved := false
+			for _, v := range unresolvedTypes {
+				if strings.EqualFold(v, resourceType) {
+					unresolved = true
+				}
+			}
+			if unresolved {
+				continue
+			}
+
+			ids[key] = id
+			actions[key] = templateDeploymentChangeDelete
+		}
+	}
+
+	keys := make([]string, 0, len(actions))
+	for k := range actions {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	changes := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		changes = append(changes, map[string]interface{}{
+			"action":      actions[k],
+			"resource_id": ids[k],
+		})
+	}
+
+	return changes
+}
+
+// flattenTemplateDeploymentValidationError returns the error (and any details) returned when validating a Template
+func flattenTemplateDeploymentValidationError(input *resources.ManagementErrorWithDetails) string {
+	var buffer bytes.Buffer
+
+	if input.Code != nil {
+		buffer.WriteString(*input.Code)
+		buffer.WriteString(": ")
+	}
+	if input.Message != nil {
+		buffer.WriteString(*input.Message)
+	}
+	if input.Details != nil {
+		for _, detail := range *input.Details {
+			buffer.WriteString("\n  - ")
+			buffer.WriteString(flattenTemplateDeploymentValidationError(&detail))
+		}
+	}
+
+	return buffer.String()
+}
@@ -0,0 +1,226 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTemplateDeploymentMissingParameters(t *testing.T) {
+	template := map[string]interface{}{
+		"parameters": map[string]interface{}{
+			"location": map[string]interface{}{
+				"type":         "string",
+				"defaultValue": "[resourceGroup().location]",
+			},
+			"storageAccountName": map[string]interface{}{
+				"type": "string",
+			},
+			"storageAccountType": map[string]interface{}{
+				"type": "string",
+			},
+		},
+	}
+
+	cases := []struct {
+		Values   map[string]interface{}
+		Expected []string
+	}{
+		{
+			Values:   map[string]interface{}{},
+			Expected: []string{"storageAccountName", "storageAccountType"},
+		},
+		{
+			Values: map[string]interface{}{
+				"storageaccountname": "example",
+			},
+			Expected: []string{"storageAccountType"},
+		},
+		{
+			Values: map[string]interface{}{
+				"storageaccountname": "example",
+				"storageaccounttype": "Standard_LRS",
+			},
+			Expected: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		actual := templateDeploymentMissingParameters(template, tc.Values)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestTemplateDeploymentParameterValues(t *testing.T) {
+	cases := []struct {
+		Parameters     map[string]interface{}
+		ParametersBody string
+		Expected       map[string]interface{}
+		ExpectError    bool
+	}{
+		{
+			Parameters: map[string]interface{}{
+				"storageAccountName": "example",
+			},
+			Expected: map[string]interface{}{
+				"storageaccountname": "example",
+			},
+		},
+		{
+			ParametersBody: `{"storageAccountName":{"value":"example"},"adminPassword":{"reference":{"secretName":"password"}}}`,
+			Expected: map[string]interface{}{
+				"storageaccountname": "example",
+				"adminpassword":      nil,
+			},
+		},
+		{
+			ParametersBody: `{`,
+			ExpectError:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		actual, err := templateDeploymentParameterValues(tc.Parameters, tc.ParametersBody)
+		if err != nil {
+			if !tc.ExpectError {
+				t.Fatalf("Got an error when none was expected: %+v", err)
+			}
+			continue
+		}
+		if tc.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestTemplateDeploymentTemplateResourceIds(t *testing.T) {
+	resourceGroupId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+	template := map[string]interface{}{
+		"parameters": map[string]interface{}{
+			"networkName": map[string]interface{}{
+				"type":         "string",
+				"defaultValue": "example-network",
+			},
+			"storageAccountName": map[string]interface{}{
+				"type": "string",
+			},
+		},
+		"variables": map[string]interface{}{
+			"storageAccountName": "[parameters('storageAccountName')]",
+			"publicIPName":       "[concat('pip-', uniqueString(resourceGroup().id))]",
+		},
+		"resources": []interface{}{
+			map[string]interface{}{
+				"type": "Microsoft.Storage/storageAccounts",
+				"name": "[variables('storageAccountName')]",
+			},
+			map[string]interface{}{
+				"type": "Microsoft.Network/virtualNetworks",
+				"name": "[parameters('networkName')]",
+			},
+			map[string]interface{}{
+				"type": "Microsoft.Network/virtualNetworks/subnets",
+				"name": "[concat(parameters('networkName'), '/internal')]",
+			},
+			map[string]interface{}{
+				"type": "Microsoft.Network/networkSecurityGroups/securityRules",
+				"name": "example-nsg/allow-ssh",
+			},
+			map[string]interface{}{
+				"type": "Microsoft.Network/publicIPAddresses",
+				"name": "[variables('publicIPName')]",
+			},
+			map[string]interface{}{
+				"type": "Microsoft.Network/networkInterfaces",
+				"name": "[[example]",
+			},
+		},
+	}
+	values := map[string]interface{}{
+		"storageaccountname": "examplesa",
+	}
+
+	ids, unresolvedTypes := templateDeploymentTemplateResourceIds(resourceGroupId, template, values)
+	expectedIds := []string{
+		resourceGroupId + "/providers/Microsoft.Storage/storageAccounts/examplesa",
+		resourceGroupId + "/providers/Microsoft.Network/virtualNetworks/example-network",
+		resourceGroupId + "/providers/Microsoft.Network/networkSecurityGroups/example-nsg/securityRules/allow-ssh",
+		resourceGroupId + "/providers/Microsoft.Network/networkInterfaces/[example]",
+	}
+	if !reflect.DeepEqual(ids, expectedIds) {
+		t.Fatalf("Expected the ID's %+v but got %+v", expectedIds, ids)
+	}
+
+	expectedUnresolvedTypes := []string{
+		"Microsoft.Network/virtualNetworks/subnets",
+		"Microsoft.Network/publicIPAddresses",
+	}
+	if !reflect.DeepEqual(unresolvedTypes, expectedUnresolvedTypes) {
+		t.Fatalf("Expected the unresolved Types %+v but got %+v", expectedUnresolvedTypes, unresolvedTypes)
+	}
+}
+
+func TestTemplateDeploymentPlannedChanges(t *testing.T) {
+	resourceGroupId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+	storageAccountId := resourceGroupId + "/providers/Microsoft.Storage/storageAccounts/example"
+	networkId := resourceGroupId + "/providers/Microsoft.Network/virtualNetworks/example"
+	subnetId := networkId + "/subnets/internal"
+	publicIPId := resourceGroupId + "/providers/Microsoft.Network/publicIPAddresses/example"
+
+	cases := []struct {
+		Planned         []string
+		Current         []string
+		UnresolvedTypes []string
+		Complete        bool
+		Expected        []interface{}
+	}{
+		{
+			// a new deployment
+			Planned: []string{storageAccountId, subnetId},
+			Current: []string{},
+			Expected: []interface{}{
+				map[string]interface{}{"action": "Create", "resource_id": storageAccountId},
+			},
+		},
+		{
+			// resource ID's are case-insensitive
+			Planned: []string{storageAccountId, networkId},
+			Current: []string{publicIPId, "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example/providers/Microsoft.Storage/storageAccounts/EXAMPLE"},
+			Expected: []interface{}{
+				map[string]interface{}{"action": "Create", "resource_id": networkId},
+				map[string]interface{}{"action": "Modify", "resource_id": storageAccountId},
+			},
+		},
+		{
+			Planned:  []string{storageAccountId},
+			Current:  []string{publicIPId, storageAccountId},
+			Complete: true,
+			Expected: []interface{}{
+				map[string]interface{}{"action": "Delete", "resource_id": publicIPId},
+				map[string]interface{}{"action": "Modify", "resource_id": storageAccountId},
+			},
+		},
+		{
+			// the Public IP may still be defined in the Template
+			Planned:         []string{storageAccountId},
+			Current:         []string{publicIPId, storageAccountId},
+			UnresolvedTypes: []string{"Microsoft.Network/publicIPAddresses"},
+			Complete:        true,
+			Expected: []interface{}{
+				map[string]interface{}{"action": "Modify", "resource_id": storageAccountId},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := templateDeploymentPlannedChanges(tc.Planned, tc.Current, tc.UnresolvedTypes, tc.Complete)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("Case %d: Expected %+v but got %+v", i, tc.Expected, actual)
+		}
+	}
+}
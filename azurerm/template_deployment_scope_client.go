@@ -0,0 +1,182 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Deployments can only be made at the Subscription and Management Group scopes from version 2019-05-01 of the
+// Resources API, which is first available in v31.0.0 of the Azure SDK. The Futures in that version call
+// `DoneWithContext`, which is only available from go-autorest v11 - which either pulls in OpenCensus (v11) or
+// drops the `Done` method the rest of the vendored Azure SDK relies on (v12 onwards), so it can't be vendored
+// without upgrading the Azure SDK as a whole. Until then this is a minimal client for Deployments which supports
+// any scope, e.g. `/subscriptions/00000000-0000-0000-0000-000000000000`,
+// `/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example` or
+// `/providers/Microsoft.Management/managementGroups/example`.
+const templateDeploymentsAtScopeAPIVersion = "2019-05-01"
+
+type templateDeploymentsAtScopeClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+// templateDeploymentAtScope is a Deployment which (unlike `resources.Deployment`) has a Location, which is
+// required for Deployments at the Subscription and Management Group scopes
+type templateDeploymentAtScope struct {
+	Location   *string                         `json:"location,omitempty"`
+	Properties *resources.DeploymentProperties `json:"properties,omitempty"`
+}
+
+type templateDeploymentAtScopeExtended struct {
+	autorest.Response `json:"-"`
+
+	ID         *string                                 `json:"id,omitempty"`
+	Name       *string                                 `json:"name,omitempty"`
+	Location   *string                                 `json:"location,omitempty"`
+	Properties *resources.DeploymentPropertiesExtended `json:"properties,omitempty"`
+}
+
+func newTemplateDeploymentsAtScopeClientWithBaseURI(baseURI string) templateDeploymentsAtScopeClient {
+	return templateDeploymentsAtScopeClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: baseURI,
+	}
+}
+
+func (client templateDeploymentsAtScopeClient) CreateOrUpdate(ctx context.Context, scope, name string, parameters templateDeploymentAtScope) (azure.Future, error) {
+	req, err := client.preparer(ctx, templateDeploymentAtScopePath(scope, name),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "azurerm.templateDeploymentsAtScopeClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "azurerm.templateDeploymentsAtScopeClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	if err := autorest.Respond(resp, azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated)); err != nil {
+		return azure.Future{}, autorest.NewErrorWithError(err, "azurerm.templateDeploymentsAtScopeClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return azure.NewFutureFromResponse(resp)
+}
+
+func (client templateDeploymentsAtScopeClient) Get(ctx context.Context, scope, name string) (result templateDeploymentAtScopeExtended, err error) {
+	req, err := client.preparer(ctx, templateDeploymentAtScopePath(scope, name), autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.templateDeploymentsAtScopeClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "azurerm.templateDeploymentsAtScopeClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.templateDeploymentsAtScopeClient", "Get", resp, "Failure responding to request")
+	}
+	return
+}
+
+// Delete starts deleting the Deployment (which doesn't delete the resources it deployed) - the Deployment
+// then needs to be polled until it's no longer found.
+func (client templateDeploymentsAtScopeClient) Delete(ctx context.Context, scope, name string) (result autorest.Response, err error) {
+	req, err := client.preparer(ctx, templateDeploymentAtScopePath(scope, name), autorest.AsDelete())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.templateDeploymentsAtScopeClient", "Delete", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = resp
+		err = autorest.NewErrorWithError(err, "azurerm.templateDeploymentsAtScopeClient", "Delete", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted, http.StatusNoContent),
+		autorest.ByClosing())
+	result.Response = resp
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "azurerm.templateDeploymentsAtScopeClient", "Delete", resp, "Failure responding to request")
+	}
+	return
+}
+
+// ListOperations returns all of the Operations performed by the Deployment, following any `nextLink`'s
+func (client templateDeploymentsAtScopeClient) ListOperations(ctx context.Context, scope, name string) ([]resources.DeploymentOperation, error) {
+	operations := make([]resources.DeploymentOperation, 0)
+
+	req, err := client.preparer(ctx, templateDeploymentAtScopePath(scope, name)+"/operations", autorest.AsGet())
+	for {
+		if err != nil {
+			return nil, autorest.NewErrorWithError(err, "azurerm.templateDeploymentsAtScopeClient", "ListOperations", nil, "Failure preparing request")
+		}
+
+		resp, err := autorest.SendWithSender(client, req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+		if err != nil {
+			return nil, autorest.NewErrorWithError(err, "azurerm.templateDeploymentsAtScopeClient", "ListOperations", resp, "Failure sending request")
+		}
+
+		var page resources.DeploymentOperationsListResult
+		err = autorest.Respond(
+			resp,
+			client.ByInspecting(),
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingJSON(&page),
+			autorest.ByClosing())
+		if err != nil {
+			return nil, autorest.NewErrorWithError(err, "azurerm.templateDeploymentsAtScopeClient", "ListOperations", resp, "Failure responding to request")
+		}
+
+		if page.Value != nil {
+			operations = append(operations, *page.Value...)
+		}
+
+		if page.NextLink == nil || *page.NextLink == "" {
+			return operations, nil
+		}
+
+		req, err = autorest.Prepare((&http.Request{}).WithContext(ctx),
+			autorest.AsGet(),
+			autorest.WithBaseURL(*page.NextLink))
+	}
+}
+
+func (client templateDeploymentsAtScopeClient) preparer(ctx context.Context, path string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": templateDeploymentsAtScopeAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath(path),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func templateDeploymentAtScopePath(scope, name string) string {
+	return fmt.Sprintf("%s/providers/Microsoft.Resources/deployments/%s", scope, url.PathEscape(name))
+}